@@ -1,11 +1,16 @@
 package binaryheap
 
 import (
+	"math/rand"
+	"slices"
+	"sort"
 	"testing"
+	"testing/quick"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/camdencheek/datastructures/compare"
+	"github.com/camdencheek/datastructures/vec"
 )
 
 func CompareInts(a, b int) compare.Result {
@@ -29,3 +34,113 @@ func TestBinaryHeap(t *testing.T) {
 	require.Equal(t, 2, *bh.Pop())
 	require.Nil(t, bh.Pop())
 }
+
+func TestBinaryHeapAll(t *testing.T) {
+	bh := FromSlice(CompareInts, []int{3, 1, 2})
+	res := slices.Collect(bh.All())
+	slices.Sort(res)
+	require.Equal(t, []int{1, 2, 3}, res)
+
+	backward := slices.Collect(bh.Backward())
+	slices.Reverse(backward)
+	require.Equal(t, slices.Collect(bh.All()), backward)
+}
+
+func TestFromSlice(t *testing.T) {
+	t.Run("pops in descending order", func(t *testing.T) {
+		f := func(items []int) bool {
+			expected := append([]int(nil), items...)
+			sort.Sort(sort.Reverse(sort.IntSlice(expected)))
+
+			bh := FromSlice(CompareInts, items)
+			for _, val := range expected {
+				if popped := bh.Pop(); popped == nil || *popped != val {
+					return false
+				}
+			}
+			return bh.Pop() == nil
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+
+	t.Run("FromVec", func(t *testing.T) {
+		bh := FromVec(CompareInts, vec.New(3, 1, 4, 1, 5))
+		require.Equal(t, 5, *bh.Peek())
+		require.Equal(t, 5, bh.Len())
+	})
+}
+
+func TestBinaryHeapBulk(t *testing.T) {
+	t.Run("Extend", func(t *testing.T) {
+		f := func(first, second []int) bool {
+			bh := New(CompareInts)
+			for _, item := range first {
+				bh.Push(item)
+			}
+			bh.Extend(second)
+			return slices.Equal(sorted(first, second), bh.IntoSortedVec())
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+
+	t.Run("PushPop", func(t *testing.T) {
+		bh := New(CompareInts)
+		require.Equal(t, 1, bh.PushPop(1))
+		require.Equal(t, 0, bh.Len())
+
+		bh.Extend([]int{2, 5, 3})
+		require.Equal(t, 6, bh.PushPop(6))
+		require.Equal(t, 5, bh.PushPop(4))
+		require.Equal(t, []int{2, 3, 4}, []int(bh.IntoSortedVec()))
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		bh := New(CompareInts)
+		require.Nil(t, bh.Replace(1))
+		require.Equal(t, 1, bh.Len())
+
+		bh.Extend([]int{2, 5, 3})
+		require.Equal(t, 5, *bh.Replace(6))
+		require.Equal(t, 6, *bh.Replace(0))
+		require.Equal(t, []int{0, 1, 2, 3}, []int(bh.IntoSortedVec()))
+	})
+
+	t.Run("IntoSortedVec", func(t *testing.T) {
+		f := func(items []int) bool {
+			bh := FromSlice(CompareInts, append([]int{}, items...))
+			res := bh.IntoSortedVec()
+			return bh.Len() == 0 && slices.Equal(sorted(items), res)
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+}
+
+func sorted(parts ...[]int) []int {
+	res := []int{}
+	for _, s := range parts {
+		res = append(res, s...)
+	}
+	sort.Ints(res)
+	return res
+}
+
+func BenchmarkBuildHeap(b *testing.B) {
+	items := rand.New(rand.NewSource(0)).Perm(100000)
+
+	b.Run("repeated Push", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bh := New(CompareInts)
+			for _, item := range items {
+				bh.Push(item)
+			}
+		}
+	})
+
+	b.Run("FromSlice", func(b *testing.B) {
+		buf := make([]int, len(items))
+		for i := 0; i < b.N; i++ {
+			copy(buf, items)
+			FromSlice(CompareInts, buf)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package binaryheap
+
+import (
+	"github.com/camdencheek/datastructures/compare"
+	"github.com/camdencheek/datastructures/vec"
+)
+
+// Bounded keeps the k greatest values pushed onto it, evicting the least
+// value whenever it would grow beyond k values.
+type Bounded[T any] struct {
+	heap MinMax[T]
+	k    int
+}
+
+// NewBounded creates a heap that holds at most k values.
+// NewBounded panics if k is less than one.
+func NewBounded[T any](k int, cmp compare.CompareFunc[T]) *Bounded[T] {
+	if k < 1 {
+		panic("binaryheap: bound must be positive")
+	}
+	return &Bounded[T]{heap: MinMax[T]{cmp: cmp}, k: k}
+}
+
+// K returns the maximum number of values the heap holds.
+func (b *Bounded[T]) K() int {
+	return b.k
+}
+
+func (b *Bounded[T]) Len() int {
+	return b.heap.Len()
+}
+
+// Push adds the value to the heap. If the heap is already full, the least
+// value, which may be the pushed value itself, is evicted and returned.
+// Otherwise, Push returns nil.
+func (b *Bounded[T]) Push(val T) *T {
+	if b.heap.Len() < b.k {
+		b.heap.Push(val)
+		return nil
+	}
+	if !b.heap.cmp.Greater(val, b.heap.data[0]) {
+		return &val
+	}
+	evicted := b.heap.data[0]
+	b.heap.data[0] = val
+	b.heap.pushDown(0, false)
+	return &evicted
+}
+
+// PeekMin returns the least value in the heap, or nil if the heap is empty.
+func (b *Bounded[T]) PeekMin() *T {
+	return b.heap.PeekMin()
+}
+
+// PeekMax returns the greatest value in the heap, or nil if the heap is empty.
+func (b *Bounded[T]) PeekMax() *T {
+	return b.heap.PeekMax()
+}
+
+// PopMin removes and returns the least value in the heap,
+// or nil if the heap is empty.
+func (b *Bounded[T]) PopMin() *T {
+	return b.heap.PopMin()
+}
+
+// PopMax removes and returns the greatest value in the heap,
+// or nil if the heap is empty.
+func (b *Bounded[T]) PopMax() *T {
+	return b.heap.PopMax()
+}
+
+// IntoSortedVec returns the values of the heap in ascending order.
+// The heap is empty afterwards.
+func (b *Bounded[T]) IntoSortedVec() vec.Vec[T] {
+	return b.heap.IntoSortedVec()
+}
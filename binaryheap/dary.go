@@ -0,0 +1,221 @@
+package binaryheap
+
+import (
+	"iter"
+
+	"github.com/camdencheek/datastructures/compare"
+	"github.com/camdencheek/datastructures/iterator"
+	"github.com/camdencheek/datastructures/vec"
+)
+
+// DAry is a heap where each node has up to arity children rather than two.
+// Higher arities make the heap shallower, trading more comparisons per level
+// in Pop for fewer levels and better cache locality. It has the same API as
+// BinaryHeap, which is a DAry heap with an arity of two.
+type DAry[T any] struct {
+	dary[T]
+}
+
+// NewDAry creates an empty heap with the given arity.
+// NewDAry panics if arity is less than 2.
+func NewDAry[T any](arity int, cmp compare.CompareFunc[T]) *DAry[T] {
+	if arity < 2 {
+		panic("binaryheap: arity must be at least 2")
+	}
+	return &DAry[T]{dary[T]{cmp: cmp, arity: arity}}
+}
+
+// DAryFromSlice creates a heap with the given arity containing the values of
+// the slice in O(n) time. The heap takes ownership of the slice and reorders
+// it in place.
+func DAryFromSlice[T any](arity int, cmp compare.CompareFunc[T], vals []T) *DAry[T] {
+	return DAryFromVec(arity, cmp, vec.NewFromSlice(vals))
+}
+
+// DAryFromVec creates a heap with the given arity containing the values of
+// the vec in O(n) time. The heap takes ownership of the vec and reorders it
+// in place.
+func DAryFromVec[T any](arity int, cmp compare.CompareFunc[T], v vec.Vec[T]) *DAry[T] {
+	d := NewDAry(arity, cmp)
+	d.data = v
+	d.rebuild()
+	return d
+}
+
+// Arity returns the maximum number of children of each node in the heap.
+func (d *DAry[T]) Arity() int {
+	return d.arity
+}
+
+// dary holds the values and the heap logic shared by DAry and BinaryHeap.
+type dary[T any] struct {
+	data  vec.Vec[T]
+	cmp   compare.CompareFunc[T]
+	arity int
+	// onSwap, if set, is called after the values at i and j are swapped
+	onSwap func(i, j int)
+}
+
+func (d *dary[T]) Len() int {
+	return len(d.data)
+}
+
+func (d *dary[T]) Push(val T) {
+	d.data.Push(val)
+	d.siftUp(0, d.data.Len()-1)
+}
+
+func (d *dary[T]) Pop() *T {
+	replacement := d.data.Pop()
+	if replacement == nil {
+		return nil
+	}
+
+	if d.data.Len() == 0 {
+		return replacement
+	}
+
+	max := d.data[0]
+	d.data[0] = *replacement
+	d.siftDown(0)
+	return &max
+}
+
+func (d *dary[T]) Peek() *T {
+	if d.data.Len() == 0 {
+		return nil
+	}
+	return &d.data[0]
+}
+
+// Iter returns an iterator over the values of the heap in no particular
+// order. The heap must not be modified while iterating.
+func (d *dary[T]) Iter() iterator.Iterator[T] {
+	return d.data.Iter()
+}
+
+// All returns a sequence that yields the values of the heap in no particular
+// order. The heap must not be modified while iterating.
+func (d *dary[T]) All() iter.Seq[T] {
+	return d.data.All()
+}
+
+// Backward returns a sequence that yields the values of the heap in the
+// reverse of the order used by All. The heap must not be modified while
+// iterating.
+func (d *dary[T]) Backward() iter.Seq[T] {
+	return d.data.Backward()
+}
+
+// Extend adds all the values to the heap. If there are enough values
+// relative to the size of the heap, the heap is rebuilt from scratch in
+// O(n) time rather than pushing each value.
+func (d *dary[T]) Extend(vals []T) {
+	if len(vals) >= d.data.Len() {
+		d.data.AppendSlice(vals)
+		d.rebuild()
+		return
+	}
+	for _, val := range vals {
+		d.Push(val)
+	}
+}
+
+// PushPop pushes the value onto the heap, then pops and returns the greatest
+// value. It is faster than calling Push followed by Pop.
+func (d *dary[T]) PushPop(val T) T {
+	if d.data.Len() == 0 || !d.cmp.Less(val, d.data[0]) {
+		return val
+	}
+	max := d.data[0]
+	d.data[0] = val
+	d.siftDown(0)
+	return max
+}
+
+// Replace pops the greatest value from the heap, then pushes the value onto
+// the heap. It returns the popped value, or nil if the heap was empty. It is
+// faster than calling Pop followed by Push.
+func (d *dary[T]) Replace(val T) *T {
+	if d.data.Len() == 0 {
+		d.data.Push(val)
+		return nil
+	}
+	max := d.data[0]
+	d.data[0] = val
+	d.siftDown(0)
+	return &max
+}
+
+// IntoSortedVec sorts the values of the heap in place in ascending order and
+// returns them. The heap is empty afterwards.
+func (d *dary[T]) IntoSortedVec() vec.Vec[T] {
+	for end := d.data.Len() - 1; end > 0; end-- {
+		d.swap(0, end)
+		d.siftDownRange(0, end)
+	}
+	sorted := d.data
+	d.data = nil
+	return sorted
+}
+
+// rebuild restores the heap order of the whole heap using Floyd's
+// bottom-up algorithm.
+func (d *dary[T]) rebuild() {
+	if d.data.Len() < 2 {
+		return
+	}
+	for pos := (d.data.Len() - 2) / d.arity; pos >= 0; pos-- {
+		d.siftDown(pos)
+	}
+}
+
+func (d *dary[T]) siftDown(pos int) int {
+	return d.siftDownRange(pos, d.data.Len())
+}
+
+// siftDownRange sifts the value at pos down, treating only the values
+// before end as part of the heap.
+func (d *dary[T]) siftDownRange(pos, end int) int {
+	for {
+		first := d.arity*pos + 1
+		if first >= end {
+			break
+		}
+		last := min(first+d.arity, end)
+
+		largest := pos
+		for child := first; child < last; child++ {
+			if d.cmp.Greater(d.data[child], d.data[largest]) {
+				largest = child
+			}
+		}
+
+		if largest == pos {
+			break
+		}
+
+		d.swap(largest, pos)
+		pos = largest
+	}
+	return pos
+}
+
+func (d *dary[T]) siftUp(start, pos int) int {
+	for pos > start {
+		parent := (pos - 1) / d.arity
+		if d.cmp.Less(d.data[pos], d.data[parent]) {
+			break
+		}
+		d.swap(parent, pos)
+		pos = parent
+	}
+	return pos
+}
+
+func (d *dary[T]) swap(i, j int) {
+	d.data[i], d.data[j] = d.data[j], d.data[i]
+	if d.onSwap != nil {
+		d.onSwap(i, j)
+	}
+}
@@ -0,0 +1,161 @@
+package binaryheap
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/camdencheek/datastructures/compare"
+)
+
+func TestDAry(t *testing.T) {
+	t.Run("invalid arity", func(t *testing.T) {
+		require.Panics(t, func() { NewDAry(1, CompareInts) })
+	})
+
+	for _, arity := range []int{2, 3, 4, 8} {
+		arity := arity
+		t.Run(fmt.Sprintf("arity %d", arity), func(t *testing.T) {
+			t.Run("Push Pop", func(t *testing.T) {
+				f := func(items []int) bool {
+					d := NewDAry(arity, CompareInts)
+					for _, item := range items {
+						d.Push(item)
+					}
+					expected := sorted(items)
+					for i := len(expected) - 1; i >= 0; i-- {
+						if peeked := d.Peek(); peeked == nil || *peeked != expected[i] {
+							return false
+						}
+						if popped := d.Pop(); popped == nil || *popped != expected[i] {
+							return false
+						}
+					}
+					return d.Pop() == nil && d.Peek() == nil
+				}
+				require.NoError(t, quick.Check(f, nil))
+			})
+
+			t.Run("DAryFromSlice IntoSortedVec", func(t *testing.T) {
+				f := func(items []int) bool {
+					d := DAryFromSlice(arity, CompareInts, append([]int{}, items...))
+					return slices.Equal(sorted(items), d.IntoSortedVec()) && d.Len() == 0
+				}
+				require.NoError(t, quick.Check(f, nil))
+			})
+
+			t.Run("Extend PushPop Replace", func(t *testing.T) {
+				f := func(first, second []int, pushPop, replace int) bool {
+					d := NewDAry(arity, CompareInts)
+					for _, item := range first {
+						d.Push(item)
+					}
+					d.Extend(second)
+					model := sorted(first, second)
+
+					popped := d.PushPop(pushPop)
+					model = sorted(model, []int{pushPop})
+					if popped != model[len(model)-1] {
+						return false
+					}
+					model = model[:len(model)-1]
+
+					replaced := d.Replace(replace)
+					if len(model) == 0 {
+						if replaced != nil {
+							return false
+						}
+					} else {
+						if replaced == nil || *replaced != model[len(model)-1] {
+							return false
+						}
+						model = model[:len(model)-1]
+					}
+					model = sorted(model, []int{replace})
+
+					return slices.Equal(model, d.IntoSortedVec())
+				}
+				require.NoError(t, quick.Check(f, nil))
+			})
+		})
+	}
+
+	t.Run("arity 2 matches BinaryHeap", func(t *testing.T) {
+		// Values are compared only by key, so values with equal keys are
+		// popped in an order that depends on how the heap breaks ties.
+		type keyed struct{ key, id int }
+		cmp := func(a, b keyed) compare.Result { return CompareInts(a.key, b.key) }
+		f := func(keys []uint8, extend []uint8) bool {
+			bh, d := New(cmp), NewDAry(2, cmp)
+			for i, key := range keys {
+				bh.Push(keyed{int(key % 8), i})
+				d.Push(keyed{int(key % 8), i})
+			}
+			var more []keyed
+			for i, key := range extend {
+				more = append(more, keyed{int(key % 8), len(keys) + i})
+			}
+			bh.Extend(slices.Clone(more))
+			d.Extend(slices.Clone(more))
+			for {
+				a, b := bh.Pop(), d.Pop()
+				if a == nil || b == nil {
+					return a == nil && b == nil
+				}
+				if *a != *b {
+					return false
+				}
+			}
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+}
+
+func BenchmarkDAry(b *testing.B) {
+	const n = 10000
+	rng := rand.New(rand.NewSource(0))
+	keys := rng.Perm(n)
+
+	benchmarkDAry(b, "8B", keys, func(k int) int { return k }, CompareInts)
+	benchmarkDAry(b, "32B", keys, func(k int) [4]int64 { return [4]int64{int64(k)} }, compareFirst[[4]int64])
+	benchmarkDAry(b, "128B", keys, func(k int) [16]int64 { return [16]int64{int64(k)} }, compareFirst[[16]int64])
+}
+
+func compareFirst[A interface{ [4]int64 | [16]int64 }](a, b A) compare.Result {
+	return CompareInts(int(a[0]), int(b[0]))
+}
+
+func benchmarkDAry[T any](b *testing.B, size string, keys []int, mk func(int) T, cmp compare.CompareFunc[T]) {
+	items := make([]T, len(keys))
+	for i, k := range keys {
+		items[i] = mk(k)
+	}
+
+	for _, arity := range []int{2, 4, 8, 16} {
+		b.Run(fmt.Sprintf("%s/arity=%d", size, arity), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				d := NewDAry(arity, cmp)
+				for _, item := range items {
+					d.Push(item)
+				}
+				for d.Pop() != nil {
+				}
+			}
+		})
+	}
+
+	b.Run(fmt.Sprintf("%s/BinaryHeap", size), func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bh := New(cmp)
+			for _, item := range items {
+				bh.Push(item)
+			}
+			for bh.Pop() != nil {
+			}
+		}
+	})
+}
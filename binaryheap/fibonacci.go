@@ -0,0 +1,285 @@
+package binaryheap
+
+import (
+	"github.com/camdencheek/datastructures/compare"
+)
+
+// Fibonacci is a pointer-based heap that supports melding two heaps in O(1)
+// time and moving a value towards the top in amortized O(1) time. The latter
+// is the DecreaseKey operation of a min-heap, which is available through
+// Update on a heap created with an inverted CompareFunc.
+type Fibonacci[T any] struct {
+	// root is the greatest node in the circular list of roots
+	root   *FibonacciNode[T]
+	length int
+	cmp    compare.CompareFunc[T]
+	// roots and degrees are scratch space for consolidate, kept to avoid
+	// reallocating
+	roots   []*FibonacciNode[T]
+	degrees []*FibonacciNode[T]
+}
+
+// FibonacciNode is a handle to a value in a Fibonacci heap. It stays valid
+// until the value is popped or removed from the heap.
+type FibonacciNode[T any] struct {
+	val    T
+	parent *FibonacciNode[T]
+	// child is any one of the node's children
+	child *FibonacciNode[T]
+	// left and right link the node into a circular list of siblings
+	left, right *FibonacciNode[T]
+	degree      int
+	// marked is set when the node has lost a child since it was last
+	// made the child of another node
+	marked bool
+	// live is set while the node is in a heap
+	live bool
+}
+
+// Value returns the value the node holds.
+func (n *FibonacciNode[T]) Value() T {
+	return n.val
+}
+
+func NewFibonacci[T any](cmp compare.CompareFunc[T]) *Fibonacci[T] {
+	return &Fibonacci[T]{cmp: cmp}
+}
+
+func (f *Fibonacci[T]) Len() int {
+	return f.length
+}
+
+func (f *Fibonacci[T]) Push(val T) {
+	f.PushNode(val)
+}
+
+// PushNode adds the value to the heap and returns a node that refers to it.
+func (f *Fibonacci[T]) PushNode(val T) *FibonacciNode[T] {
+	n := &FibonacciNode[T]{val: val, live: true}
+	f.insertRoot(n)
+	f.length++
+	return n
+}
+
+func (f *Fibonacci[T]) Peek() *T {
+	if f.root == nil {
+		return nil
+	}
+	return &f.root.val
+}
+
+func (f *Fibonacci[T]) Pop() *T {
+	popped := f.root
+	if popped == nil {
+		return nil
+	}
+
+	// Promote all children of the root to roots
+	if child := popped.child; child != nil {
+		n := child
+		for {
+			n.parent = nil
+			n.marked = false
+			n = n.right
+			if n == child {
+				break
+			}
+		}
+		splice(popped, child)
+		popped.child = nil
+		popped.degree = 0
+	}
+
+	next := popped.right
+	unlink(popped)
+	popped.live = false
+	f.length--
+	if f.length == 0 {
+		f.root = nil
+	} else {
+		f.root = next
+		f.consolidate()
+	}
+	return &popped.val
+}
+
+// Meld moves all the values of other into the heap in O(1) time, leaving
+// other empty. Both heaps must use the same ordering. Melding a heap with
+// itself does nothing.
+func (f *Fibonacci[T]) Meld(other *Fibonacci[T]) {
+	if other == f || other.root == nil {
+		return
+	}
+	if f.root == nil {
+		f.root = other.root
+	} else {
+		splice(f.root, other.root)
+		if f.cmp.Greater(other.root.val, f.root.val) {
+			f.root = other.root
+		}
+	}
+	f.length += other.length
+	other.root = nil
+	other.length = 0
+}
+
+// Update replaces the value of the node and restores the heap order. If the
+// new value is not less than the old one, this takes amortized O(1) time.
+// Update panics if the node has been popped or removed.
+func (f *Fibonacci[T]) Update(n *FibonacciNode[T], val T) {
+	checkLive(n.live)
+	if f.cmp.Less(val, n.val) {
+		f.Remove(n)
+		n.val = val
+		n.live = true
+		f.insertRoot(n)
+		f.length++
+		return
+	}
+
+	n.val = val
+	if parent := n.parent; parent != nil && f.cmp.Greater(n.val, parent.val) {
+		f.cut(n)
+		f.cascadingCut(parent)
+	}
+	if f.cmp.Greater(n.val, f.root.val) {
+		f.root = n
+	}
+}
+
+// Remove removes the node from the heap and returns its value. Remove panics
+// if the node has already been popped or removed.
+func (f *Fibonacci[T]) Remove(n *FibonacciNode[T]) T {
+	checkLive(n.live)
+	if parent := n.parent; parent != nil {
+		f.cut(n)
+		f.cascadingCut(parent)
+	}
+	// Treat the node as the greatest so that Pop removes it
+	f.root = n
+	return *f.Pop()
+}
+
+// insertRoot adds a detached node to the root list.
+func (f *Fibonacci[T]) insertRoot(n *FibonacciNode[T]) {
+	n.left, n.right = n, n
+	n.parent = nil
+	n.marked = false
+	if f.root == nil {
+		f.root = n
+		return
+	}
+	splice(f.root, n)
+	if f.cmp.Greater(n.val, f.root.val) {
+		f.root = n
+	}
+}
+
+// cut moves n from its parent's children to the root list.
+func (f *Fibonacci[T]) cut(n *FibonacciNode[T]) {
+	parent := n.parent
+	if n.right == n {
+		parent.child = nil
+	} else if parent.child == n {
+		parent.child = n.right
+	}
+	unlink(n)
+	parent.degree--
+	f.insertRoot(n)
+}
+
+// cascadingCut cuts each ancestor that has already lost a child, stopping at
+// the first one that has not, which is marked instead.
+func (f *Fibonacci[T]) cascadingCut(n *FibonacciNode[T]) {
+	for n.parent != nil {
+		if !n.marked {
+			n.marked = true
+			return
+		}
+		parent := n.parent
+		f.cut(n)
+		n = parent
+	}
+}
+
+// consolidate links roots of equal degree until every root has a distinct
+// degree, then finds the new greatest root.
+func (f *Fibonacci[T]) consolidate() {
+	roots := f.roots[:0]
+	n := f.root
+	for {
+		roots = append(roots, n)
+		n = n.right
+		if n == f.root {
+			break
+		}
+	}
+
+	degrees := f.degrees[:0]
+	for _, x := range roots {
+		x.left, x.right = x, x
+		for {
+			for len(degrees) <= x.degree {
+				degrees = append(degrees, nil)
+			}
+			y := degrees[x.degree]
+			if y == nil {
+				break
+			}
+			degrees[x.degree] = nil
+			if f.cmp.Less(x.val, y.val) {
+				x, y = y, x
+			}
+			f.addChild(x, y)
+		}
+		degrees[x.degree] = x
+	}
+	clear(roots)
+	f.roots = roots
+
+	f.root = nil
+	for i, n := range degrees {
+		if n == nil {
+			continue
+		}
+		degrees[i] = nil
+		if f.root == nil {
+			f.root = n
+			continue
+		}
+		splice(f.root, n)
+		if f.cmp.Greater(n.val, f.root.val) {
+			f.root = n
+		}
+	}
+	f.degrees = degrees
+}
+
+// addChild makes the detached root child a child of parent.
+func (f *Fibonacci[T]) addChild(parent, child *FibonacciNode[T]) {
+	child.parent = parent
+	child.marked = false
+	if parent.child == nil {
+		parent.child = child
+	} else {
+		splice(parent.child, child)
+	}
+	parent.degree++
+}
+
+// splice joins the circular list containing b into the circular list
+// containing a.
+func splice[T any](a, b *FibonacciNode[T]) {
+	aRight, bLeft := a.right, b.left
+	a.right = b
+	b.left = a
+	bLeft.right = aRight
+	aRight.left = bLeft
+}
+
+// unlink removes n from its circular list, leaving it in a list by itself.
+func unlink[T any](n *FibonacciNode[T]) {
+	n.left.right = n.right
+	n.right.left = n.left
+	n.left, n.right = n, n
+}
@@ -0,0 +1,19 @@
+package binaryheap
+
+// Heap is a priority queue that pops its greatest value first, according to
+// the CompareFunc it was created with. Use CompareFunc.Invert to pop the
+// least value first instead.
+type Heap[T any] interface {
+	Len() int
+	Push(T)
+	Pop() *T
+	Peek() *T
+}
+
+var (
+	_ Heap[int] = (*BinaryHeap[int])(nil)
+	_ Heap[int] = (*DAry[int])(nil)
+	_ Heap[int] = (*Pairing[int])(nil)
+	_ Heap[int] = (*Fibonacci[int])(nil)
+	_ Heap[int] = (*Stable[int])(nil)
+)
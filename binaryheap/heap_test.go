@@ -0,0 +1,234 @@
+package binaryheap
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeap(t *testing.T) {
+	heaps := map[string]func() Heap[int]{
+		"BinaryHeap": func() Heap[int] { return New(CompareInts) },
+		"DAry":       func() Heap[int] { return NewDAry(4, CompareInts) },
+		"Pairing":    func() Heap[int] { return NewPairing(CompareInts) },
+		"Fibonacci":  func() Heap[int] { return NewFibonacci(CompareInts) },
+		"Stable":     func() Heap[int] { return NewStable(CompareInts) },
+	}
+
+	for name, newHeap := range heaps {
+		newHeap := newHeap
+		t.Run(name, func(t *testing.T) {
+			t.Run("interleaved Push Pop matches sorted slice", func(t *testing.T) {
+				f := func(items []int, pops []uint8) bool {
+					h := newHeap()
+					var model []int
+					for i, item := range items {
+						h.Push(item)
+						model = sorted(model, []int{item})
+						if i < len(pops) && pops[i]%3 == 0 {
+							popped := h.Pop()
+							if popped == nil || *popped != model[len(model)-1] {
+								return false
+							}
+							model = model[:len(model)-1]
+						}
+					}
+					for len(model) > 0 {
+						if *h.Peek() != model[len(model)-1] || h.Len() != len(model) {
+							return false
+						}
+						if *h.Pop() != model[len(model)-1] {
+							return false
+						}
+						model = model[:len(model)-1]
+					}
+					return h.Pop() == nil && h.Peek() == nil && h.Len() == 0
+				}
+				require.NoError(t, quick.Check(f, nil))
+			})
+		})
+	}
+}
+
+// nodeHeap is implemented by the pointer-based heaps
+type nodeHeap[N any] interface {
+	Heap[int]
+	PushNode(int) N
+	Update(N, int)
+	Remove(N) int
+}
+
+func testNodeHeap[N interface{ Value() int }, H nodeHeap[N]](t *testing.T, newHeap func() H, meld func(H, H)) {
+	t.Run("Meld", func(t *testing.T) {
+		f := func(a, b []int) bool {
+			x, y := newHeap(), newHeap()
+			for _, item := range a {
+				x.Push(item)
+			}
+			// Pop once so the heap isn't just a flat list of roots
+			if len(a) > 0 {
+				x.Push(*x.Pop())
+			}
+			for _, item := range b {
+				y.Push(item)
+			}
+			meld(x, y)
+			if y.Len() != 0 || y.Pop() != nil {
+				return false
+			}
+			model := sorted(a, b)
+			for i := len(model) - 1; i >= 0; i-- {
+				if popped := x.Pop(); popped == nil || *popped != model[i] {
+					return false
+				}
+			}
+			return x.Pop() == nil
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+
+	t.Run("Meld with itself", func(t *testing.T) {
+		h := newHeap()
+		for i := 0; i < 5; i++ {
+			h.Push(i)
+		}
+		meld(h, h)
+		require.Equal(t, 5, h.Len())
+		for i := 4; i >= 0; i-- {
+			require.Equal(t, i, *h.Pop())
+		}
+		require.Nil(t, h.Pop())
+	})
+
+	t.Run("stale node", func(t *testing.T) {
+		h := newHeap()
+		a := h.PushNode(1)
+		b := h.PushNode(2)
+		h.PushNode(3)
+		require.Equal(t, 3, *h.Pop())
+		require.Equal(t, 2, h.Remove(b))
+		require.PanicsWithValue(t, "binaryheap: node is not in the heap", func() { h.Update(b, 5) })
+		require.PanicsWithValue(t, "binaryheap: node is not in the heap", func() { h.Remove(b) })
+		require.Equal(t, 1, *h.Pop())
+		require.Panics(t, func() { h.Update(a, 5) })
+		require.Equal(t, 0, h.Len())
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		h := newHeap()
+		a := h.PushNode(1)
+		b := h.PushNode(2)
+		h.PushNode(3)
+		h.Update(a, 10)
+		require.Equal(t, 10, *h.Peek())
+		require.Equal(t, 10, a.Value())
+		h.Update(a, 0)
+		require.Equal(t, 3, *h.Peek())
+		require.Equal(t, 3, *h.Pop())
+		require.Equal(t, 2, b.Value())
+		require.Equal(t, 2, *h.Pop())
+		require.Equal(t, 0, *h.Pop())
+		require.Nil(t, h.Pop())
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		h := newHeap()
+		nodes := make([]N, 10)
+		for i := range nodes {
+			nodes[i] = h.PushNode(i)
+		}
+		require.Equal(t, 4, h.Remove(nodes[4]))
+		require.Equal(t, 9, h.Remove(nodes[9]))
+		require.Equal(t, 8, h.Len())
+		for _, expected := range []int{8, 7, 6, 5, 3, 2, 1, 0} {
+			require.Equal(t, expected, *h.Pop())
+		}
+		require.Nil(t, h.Pop())
+	})
+
+	t.Run("random operations match model", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(0))
+		for round := 0; round < 50; round++ {
+			h := newHeap()
+			live := make(map[int]N)
+			values := make(map[int]int)
+			next := 0
+			// Values are kept unique so a popped value identifies its node
+			unique := 0
+			randVal := func() int {
+				unique++
+				return rng.Intn(1000)*100000 + unique
+			}
+			for op := 0; op < 300; op++ {
+				switch rng.Intn(5) {
+				case 0, 1:
+					val := randVal()
+					live[next] = h.PushNode(val)
+					values[next] = val
+					next++
+				case 2:
+					for id, n := range live {
+						val := randVal()
+						h.Update(n, val)
+						values[id] = val
+						break
+					}
+				case 3:
+					for id, n := range live {
+						require.Equal(t, values[id], h.Remove(n))
+						delete(live, id)
+						delete(values, id)
+						break
+					}
+				case 4:
+					popped := h.Pop()
+					if len(live) == 0 {
+						require.Nil(t, popped)
+						continue
+					}
+					max := -1
+					for _, val := range values {
+						if val > max {
+							max = val
+						}
+					}
+					require.Equal(t, max, *popped)
+					for id, n := range live {
+						if n.Value() == max {
+							delete(live, id)
+							delete(values, id)
+							break
+						}
+					}
+				}
+				require.Equal(t, len(live), h.Len())
+			}
+		}
+	})
+}
+
+func TestPairing(t *testing.T) {
+	testNodeHeap[*PairingNode[int]](t,
+		func() *Pairing[int] { return NewPairing(CompareInts) },
+		func(a, b *Pairing[int]) { a.Meld(b) },
+	)
+}
+
+func TestFibonacci(t *testing.T) {
+	testNodeHeap[*FibonacciNode[int]](t,
+		func() *Fibonacci[int] { return NewFibonacci(CompareInts) },
+		func(a, b *Fibonacci[int]) { a.Meld(b) },
+	)
+
+	t.Run("Pop does not allocate", func(t *testing.T) {
+		h := NewFibonacci(CompareInts)
+		for i := 0; i < 1000; i++ {
+			h.Push(i)
+		}
+		// The first Pop grows the scratch space for the whole root list
+		h.Pop()
+		require.Zero(t, testing.AllocsPerRun(100, func() { h.Pop() }))
+	})
+}
@@ -0,0 +1,146 @@
+package binaryheap
+
+import (
+	"github.com/camdencheek/datastructures/compare"
+)
+
+// Handle identifies a value pushed onto an Indexed heap. It stays valid
+// until the value is popped or removed from the heap.
+type Handle uint64
+
+type indexedEntry[T any] struct {
+	handle Handle
+	val    T
+}
+
+// Indexed is a binary heap that returns a handle for each pushed value,
+// allowing the value to be updated or removed while it is still in the heap.
+// Like BinaryHeap, the greatest value according to the heap's CompareFunc
+// is at the top.
+type Indexed[T any] struct {
+	heap BinaryHeap[indexedEntry[T]]
+	// positions maps each handle in the heap to its index in heap.data
+	positions  map[Handle]int
+	nextHandle Handle
+}
+
+func NewIndexed[T any](cmp compare.CompareFunc[T]) *Indexed[T] {
+	ix := &Indexed[T]{positions: make(map[Handle]int)}
+	ix.heap = *New(func(a, b indexedEntry[T]) compare.Result {
+		return cmp(a.val, b.val)
+	})
+	ix.heap.onSwap = ix.swapped
+	return ix
+}
+
+func (ix *Indexed[T]) swapped(i, j int) {
+	ix.positions[ix.heap.data[i].handle] = i
+	ix.positions[ix.heap.data[j].handle] = j
+}
+
+func (ix *Indexed[T]) Len() int {
+	return ix.heap.Len()
+}
+
+// Push adds the value to the heap and returns a handle that refers to it.
+func (ix *Indexed[T]) Push(val T) Handle {
+	handle := ix.nextHandle
+	ix.nextHandle++
+
+	ix.heap.data.Push(indexedEntry[T]{handle: handle, val: val})
+	pos := ix.heap.data.Len() - 1
+	ix.positions[handle] = pos
+	ix.heap.siftUp(0, pos)
+	return handle
+}
+
+// Pop removes and returns the greatest value in the heap,
+// or nil if the heap is empty.
+func (ix *Indexed[T]) Pop() *T {
+	if ix.heap.data.Len() == 0 {
+		return nil
+	}
+	entry := ix.removeAt(0)
+	return &entry.val
+}
+
+// PopHandle is like Pop, but also returns the handle of the popped value.
+// The handle is no longer valid after it is returned.
+func (ix *Indexed[T]) PopHandle() (Handle, *T) {
+	if ix.heap.data.Len() == 0 {
+		return 0, nil
+	}
+	entry := ix.removeAt(0)
+	return entry.handle, &entry.val
+}
+
+// Peek returns the greatest value in the heap, or nil if the heap is empty.
+func (ix *Indexed[T]) Peek() *T {
+	if ix.heap.data.Len() == 0 {
+		return nil
+	}
+	return &ix.heap.data[0].val
+}
+
+// Contains returns whether the handle refers to a value that is still in the
+// heap.
+func (ix *Indexed[T]) Contains(handle Handle) bool {
+	_, ok := ix.positions[handle]
+	return ok
+}
+
+// Get returns the value the handle refers to, or nil if it is no longer in
+// the heap. The value must not be modified through the returned pointer;
+// use Update instead.
+func (ix *Indexed[T]) Get(handle Handle) *T {
+	pos, ok := ix.positions[handle]
+	if !ok {
+		return nil
+	}
+	return &ix.heap.data[pos].val
+}
+
+// Update replaces the value the handle refers to and restores the heap
+// order. It returns false if the handle is no longer in the heap.
+func (ix *Indexed[T]) Update(handle Handle, val T) bool {
+	pos, ok := ix.positions[handle]
+	if !ok {
+		return false
+	}
+	ix.heap.data[pos].val = val
+	ix.fix(pos)
+	return true
+}
+
+// Remove removes and returns the value the handle refers to, or nil if the
+// handle is no longer in the heap.
+func (ix *Indexed[T]) Remove(handle Handle) *T {
+	pos, ok := ix.positions[handle]
+	if !ok {
+		return nil
+	}
+	entry := ix.removeAt(pos)
+	return &entry.val
+}
+
+// removeAt removes the entry at pos by swapping it with the last entry,
+// then restores the heap order around the entry that took its place.
+func (ix *Indexed[T]) removeAt(pos int) indexedEntry[T] {
+	last := ix.heap.data.Len() - 1
+	if pos != last {
+		ix.heap.swap(pos, last)
+	}
+	removed := ix.heap.data.PopOrZero()
+	delete(ix.positions, removed.handle)
+	if pos != last {
+		ix.fix(pos)
+	}
+	return removed
+}
+
+// fix moves the entry at pos up or down until the heap order is restored.
+func (ix *Indexed[T]) fix(pos int) {
+	if ix.heap.siftUp(0, pos) == pos {
+		ix.heap.siftDown(pos)
+	}
+}
@@ -0,0 +1,111 @@
+package binaryheap
+
+import (
+	"sort"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexed(t *testing.T) {
+	t.Run("Push Pop", func(t *testing.T) {
+		ix := NewIndexed(CompareInts)
+		ix.Push(2)
+		ix.Push(5)
+		ix.Push(3)
+		require.Equal(t, 5, *ix.Peek())
+		require.Equal(t, 5, *ix.Pop())
+		require.Equal(t, 3, *ix.Pop())
+		require.Equal(t, 2, *ix.Pop())
+		require.Nil(t, ix.Pop())
+		require.Nil(t, ix.Peek())
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		ix := NewIndexed(CompareInts)
+		a := ix.Push(1)
+		b := ix.Push(2)
+		c := ix.Push(3)
+
+		require.True(t, ix.Update(a, 10))
+		require.Equal(t, 10, *ix.Peek())
+		require.True(t, ix.Update(a, 0))
+		require.Equal(t, 3, *ix.Peek())
+		require.Equal(t, 0, *ix.Get(a))
+
+		handle, val := ix.PopHandle()
+		require.Equal(t, c, handle)
+		require.Equal(t, 3, *val)
+		require.False(t, ix.Contains(c))
+		require.False(t, ix.Update(c, 100))
+
+		require.Equal(t, 2, *ix.Get(b))
+		require.Equal(t, 2, *ix.Pop())
+		require.Equal(t, 0, *ix.Pop())
+		require.Nil(t, ix.Pop())
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		ix := NewIndexed(CompareInts)
+		handles := make([]Handle, 10)
+		for i := range handles {
+			handles[i] = ix.Push(i)
+		}
+		require.Equal(t, 4, *ix.Remove(handles[4]))
+		require.Nil(t, ix.Remove(handles[4]))
+		require.Equal(t, 9, *ix.Remove(handles[9]))
+		require.Equal(t, 0, *ix.Remove(handles[0]))
+		require.True(t, ix.Contains(handles[5]))
+		require.False(t, ix.Contains(handles[4]))
+		require.Equal(t, 7, ix.Len())
+
+		for _, expected := range []int{8, 7, 6, 5, 3, 2, 1} {
+			require.Equal(t, expected, *ix.Pop())
+		}
+		require.Nil(t, ix.Pop())
+	})
+
+	t.Run("quick check matches sorted slice", func(t *testing.T) {
+		f := func(items []int, updates []int, removals []uint8) bool {
+			ix := NewIndexed(CompareInts)
+			values := make(map[Handle]int)
+			handles := make([]Handle, 0, len(items))
+			for _, item := range items {
+				h := ix.Push(item)
+				values[h] = item
+				handles = append(handles, h)
+			}
+			if len(handles) > 0 {
+				for i, update := range updates {
+					h := handles[i%len(handles)]
+					ix.Update(h, update)
+					values[h] = update
+				}
+				for _, r := range removals {
+					h := handles[int(r)%len(handles)]
+					removed := ix.Remove(h)
+					if _, ok := values[h]; ok != (removed != nil) {
+						return false
+					}
+					delete(values, h)
+				}
+			}
+
+			expected := make([]int, 0, len(values))
+			for _, val := range values {
+				expected = append(expected, val)
+			}
+			sort.Sort(sort.Reverse(sort.IntSlice(expected)))
+
+			for _, val := range expected {
+				popped := ix.Pop()
+				if popped == nil || *popped != val {
+					return false
+				}
+			}
+			return ix.Pop() == nil && len(ix.positions) == 0
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+}
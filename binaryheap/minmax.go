@@ -0,0 +1,181 @@
+package binaryheap
+
+import (
+	"math/bits"
+
+	"github.com/camdencheek/datastructures/compare"
+	"github.com/camdencheek/datastructures/vec"
+)
+
+// MinMax is a double-ended priority queue that can peek at or pop either its
+// least or its greatest value in O(log n) time. Values on even levels of the
+// tree are less than all their descendants, and values on odd levels are
+// greater than all their descendants.
+type MinMax[T any] struct {
+	data vec.Vec[T]
+	cmp  compare.CompareFunc[T]
+}
+
+func NewMinMax[T any](cmp compare.CompareFunc[T]) *MinMax[T] {
+	return &MinMax[T]{cmp: cmp}
+}
+
+func (m *MinMax[T]) Len() int {
+	return len(m.data)
+}
+
+func (m *MinMax[T]) Push(val T) {
+	m.data.Push(val)
+	pos := m.data.Len() - 1
+	if pos == 0 {
+		return
+	}
+
+	parent := (pos - 1) / 2
+	max := isMaxLevel(pos)
+	if m.before(parent, pos, max) {
+		// The value belongs on the parent's kind of level instead
+		m.data[parent], m.data[pos] = m.data[pos], m.data[parent]
+		m.pushUp(parent, !max)
+	} else {
+		m.pushUp(pos, max)
+	}
+}
+
+// PeekMin returns the least value in the heap, or nil if the heap is empty.
+func (m *MinMax[T]) PeekMin() *T {
+	if m.data.Len() == 0 {
+		return nil
+	}
+	return &m.data[0]
+}
+
+// PeekMax returns the greatest value in the heap, or nil if the heap is empty.
+func (m *MinMax[T]) PeekMax() *T {
+	if m.data.Len() == 0 {
+		return nil
+	}
+	return &m.data[m.maxPos()]
+}
+
+// PopMin removes and returns the least value in the heap,
+// or nil if the heap is empty.
+func (m *MinMax[T]) PopMin() *T {
+	if m.data.Len() == 0 {
+		return nil
+	}
+	return m.removeAt(0)
+}
+
+// PopMax removes and returns the greatest value in the heap,
+// or nil if the heap is empty.
+func (m *MinMax[T]) PopMax() *T {
+	if m.data.Len() == 0 {
+		return nil
+	}
+	return m.removeAt(m.maxPos())
+}
+
+// IntoSortedVec returns the values of the heap in ascending order.
+// The heap is empty afterwards.
+func (m *MinMax[T]) IntoSortedVec() vec.Vec[T] {
+	sorted := make(vec.Vec[T], 0, m.data.Len())
+	for m.data.Len() > 0 {
+		sorted.Push(*m.PopMin())
+	}
+	m.data = nil
+	return sorted
+}
+
+// maxPos returns the index of the greatest value. The heap must not be empty.
+func (m *MinMax[T]) maxPos() int {
+	switch m.data.Len() {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	default:
+		if m.cmp.Less(m.data[1], m.data[2]) {
+			return 2
+		}
+		return 1
+	}
+}
+
+func (m *MinMax[T]) removeAt(pos int) *T {
+	removed := m.data[pos]
+	last := m.data.PopOrZero()
+	if pos < m.data.Len() {
+		m.data[pos] = last
+		m.pushDown(pos, isMaxLevel(pos))
+	}
+	return &removed
+}
+
+// before returns whether the value at i should be closer to the root than
+// the value at j on a max level, or on a min level if max is false.
+func (m *MinMax[T]) before(i, j int, max bool) bool {
+	if max {
+		return m.cmp.Greater(m.data[i], m.data[j])
+	}
+	return m.cmp.Less(m.data[i], m.data[j])
+}
+
+// pushUp moves the value at pos up through its grandparents, which are all
+// on the same kind of level.
+func (m *MinMax[T]) pushUp(pos int, max bool) {
+	for pos > 2 {
+		grandparent := ((pos-1)/2 - 1) / 2
+		if !m.before(pos, grandparent, max) {
+			break
+		}
+		m.data[grandparent], m.data[pos] = m.data[pos], m.data[grandparent]
+		pos = grandparent
+	}
+}
+
+// pushDown moves the value at pos down until it is in order with its
+// children and grandchildren.
+func (m *MinMax[T]) pushDown(pos int, max bool) {
+	for {
+		firstChild := 2*pos + 1
+		if firstChild >= m.data.Len() {
+			return
+		}
+
+		// Find the most extreme of the children and grandchildren
+		best := firstChild
+		candidates := [...]int{
+			firstChild + 1,
+			2*firstChild + 1, 2*firstChild + 2,
+			2*firstChild + 3, 2*firstChild + 4,
+		}
+		for _, candidate := range candidates {
+			if candidate < m.data.Len() && m.before(candidate, best, max) {
+				best = candidate
+			}
+		}
+
+		if !m.before(best, pos, max) {
+			return
+		}
+		m.data[best], m.data[pos] = m.data[pos], m.data[best]
+
+		if best <= firstChild+1 {
+			// A child has no descendants on our kind of level,
+			// so there is nothing further to fix
+			return
+		}
+
+		parent := (best - 1) / 2
+		if m.before(best, parent, !max) {
+			m.data[best], m.data[parent] = m.data[parent], m.data[best]
+		}
+		pos = best
+	}
+}
+
+// isMaxLevel returns whether the index is on an odd level of the tree.
+func isMaxLevel(pos int) bool {
+	return bits.Len(uint(pos+1))%2 == 0
+}
@@ -0,0 +1,98 @@
+package binaryheap
+
+import (
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinMax(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		m := NewMinMax(CompareInts)
+		require.Nil(t, m.PeekMin())
+		require.Nil(t, m.PeekMax())
+		require.Nil(t, m.PopMin())
+		require.Nil(t, m.PopMax())
+	})
+
+	t.Run("PopMin PopMax", func(t *testing.T) {
+		m := NewMinMax(CompareInts)
+		for _, item := range []int{5, 1, 9, 3, 7} {
+			m.Push(item)
+		}
+		require.Equal(t, 1, *m.PeekMin())
+		require.Equal(t, 9, *m.PeekMax())
+		require.Equal(t, 9, *m.PopMax())
+		require.Equal(t, 1, *m.PopMin())
+		require.Equal(t, 7, *m.PopMax())
+		require.Equal(t, 3, *m.PopMin())
+		require.Equal(t, 5, *m.PopMax())
+		require.Nil(t, m.PopMin())
+	})
+
+	t.Run("quick check matches sorted slice", func(t *testing.T) {
+		f := func(items []int, pops []bool) bool {
+			m := NewMinMax(CompareInts)
+			for _, item := range items {
+				m.Push(item)
+			}
+			model := sorted(items)
+			for _, popMax := range pops {
+				if len(model) == 0 {
+					break
+				}
+				if *m.PeekMin() != model[0] || *m.PeekMax() != model[len(model)-1] {
+					return false
+				}
+				if popMax {
+					if *m.PopMax() != model[len(model)-1] {
+						return false
+					}
+					model = model[:len(model)-1]
+				} else {
+					if *m.PopMin() != model[0] {
+						return false
+					}
+					model = model[1:]
+				}
+			}
+			return reflect.DeepEqual(model, []int(m.IntoSortedVec()))
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+}
+
+func TestBounded(t *testing.T) {
+	t.Run("invalid bound", func(t *testing.T) {
+		require.Panics(t, func() { NewBounded(0, CompareInts) })
+	})
+
+	t.Run("evicts least", func(t *testing.T) {
+		b := NewBounded(2, CompareInts)
+		require.Nil(t, b.Push(5))
+		require.Nil(t, b.Push(3))
+		require.Equal(t, 3, *b.Push(8))
+		require.Equal(t, 1, *b.Push(1))
+		require.Equal(t, 2, b.Len())
+		require.Equal(t, 5, *b.PeekMin())
+		require.Equal(t, 8, *b.PeekMax())
+	})
+
+	t.Run("quick check keeps top k", func(t *testing.T) {
+		f := func(items []int, k uint8) bool {
+			bound := int(k%16) + 1
+			b := NewBounded(bound, CompareInts)
+			for _, item := range items {
+				b.Push(item)
+			}
+			expected := sorted(items)
+			if len(expected) > bound {
+				expected = expected[len(expected)-bound:]
+			}
+			return reflect.DeepEqual(expected, []int(b.IntoSortedVec()))
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+}
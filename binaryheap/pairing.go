@@ -0,0 +1,195 @@
+package binaryheap
+
+import (
+	"github.com/camdencheek/datastructures/compare"
+)
+
+// Pairing is a pointer-based heap that supports melding two heaps in O(1)
+// time and moving a value towards the top in amortized O(1) time. The latter
+// is the DecreaseKey operation of a min-heap, which is available through
+// Update on a heap created with an inverted CompareFunc.
+type Pairing[T any] struct {
+	root   *PairingNode[T]
+	length int
+	cmp    compare.CompareFunc[T]
+}
+
+// PairingNode is a handle to a value in a Pairing heap. It stays valid until
+// the value is popped or removed from the heap.
+type PairingNode[T any] struct {
+	val   T
+	child *PairingNode[T]
+	next  *PairingNode[T]
+	// prev is the previous sibling, or the parent if this is the first child
+	prev *PairingNode[T]
+	// live is set while the node is in a heap
+	live bool
+}
+
+// Value returns the value the node holds.
+func (n *PairingNode[T]) Value() T {
+	return n.val
+}
+
+func NewPairing[T any](cmp compare.CompareFunc[T]) *Pairing[T] {
+	return &Pairing[T]{cmp: cmp}
+}
+
+func (p *Pairing[T]) Len() int {
+	return p.length
+}
+
+func (p *Pairing[T]) Push(val T) {
+	p.PushNode(val)
+}
+
+// PushNode adds the value to the heap and returns a node that refers to it.
+func (p *Pairing[T]) PushNode(val T) *PairingNode[T] {
+	n := &PairingNode[T]{val: val, live: true}
+	p.root = p.link(p.root, n)
+	p.length++
+	return n
+}
+
+func (p *Pairing[T]) Peek() *T {
+	if p.root == nil {
+		return nil
+	}
+	return &p.root.val
+}
+
+func (p *Pairing[T]) Pop() *T {
+	popped := p.root
+	if popped == nil {
+		return nil
+	}
+	p.root = p.mergePairs(popped.child)
+	popped.child = nil
+	popped.live = false
+	p.length--
+	return &popped.val
+}
+
+// Meld moves all the values of other into the heap in O(1) time, leaving
+// other empty. Both heaps must use the same ordering. Melding a heap with
+// itself does nothing.
+func (p *Pairing[T]) Meld(other *Pairing[T]) {
+	if other == p {
+		return
+	}
+	p.root = p.link(p.root, other.root)
+	p.length += other.length
+	other.root = nil
+	other.length = 0
+}
+
+// Update replaces the value of the node and restores the heap order. If the
+// new value is not less than the old one, this takes amortized O(1) time.
+// Update panics if the node has been popped or removed.
+func (p *Pairing[T]) Update(n *PairingNode[T], val T) {
+	checkLive(n.live)
+	if p.cmp.Less(val, n.val) {
+		p.Remove(n)
+		n.val = val
+		n.live = true
+		p.root = p.link(p.root, n)
+		p.length++
+		return
+	}
+
+	n.val = val
+	if n != p.root {
+		p.cut(n)
+		p.root = p.link(p.root, n)
+	}
+}
+
+// Remove removes the node from the heap and returns its value. Remove panics
+// if the node has already been popped or removed.
+func (p *Pairing[T]) Remove(n *PairingNode[T]) T {
+	checkLive(n.live)
+	if n == p.root {
+		return *p.Pop()
+	}
+	p.cut(n)
+	p.root = p.link(p.root, p.mergePairs(n.child))
+	n.child = nil
+	n.live = false
+	p.length--
+	return n.val
+}
+
+// link makes the lesser of the two root nodes the first child of the other,
+// returning the new root. Either node may be nil.
+func (p *Pairing[T]) link(a, b *PairingNode[T]) *PairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if p.cmp.Less(a.val, b.val) {
+		a, b = b, a
+	}
+	b.prev = a
+	b.next = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
+
+// cut detaches the subtree rooted at n from its parent and siblings.
+func (p *Pairing[T]) cut(n *PairingNode[T]) {
+	if n.prev.child == n {
+		n.prev.child = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	}
+	n.prev = nil
+	n.next = nil
+}
+
+// mergePairs links a list of siblings into a single tree using the standard
+// two-pass strategy, returning its root.
+func (p *Pairing[T]) mergePairs(first *PairingNode[T]) *PairingNode[T] {
+	// First pass: link adjacent pairs from left to right, collecting the
+	// results in reverse order
+	var paired *PairingNode[T]
+	for first != nil {
+		a := first
+		b := a.next
+		if b != nil {
+			first = b.next
+			b.prev, b.next = nil, nil
+		} else {
+			first = nil
+		}
+		a.prev, a.next = nil, nil
+
+		merged := p.link(a, b)
+		merged.next = paired
+		paired = merged
+	}
+
+	// Second pass: link the results from right to left
+	var root *PairingNode[T]
+	for paired != nil {
+		n := paired
+		paired = n.next
+		n.next = nil
+		root = p.link(root, n)
+	}
+	return root
+}
+
+// checkLive panics if a node handle no longer refers to a value in a heap.
+func checkLive(live bool) {
+	if !live {
+		panic("binaryheap: node is not in the heap")
+	}
+}
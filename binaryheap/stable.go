@@ -0,0 +1,62 @@
+package binaryheap
+
+import (
+	"github.com/camdencheek/datastructures/compare"
+)
+
+type stableEntry[T any] struct {
+	seq uint64
+	val T
+}
+
+// Stable is a binary heap that pops values that compare as equal in the
+// order they were pushed.
+type Stable[T any] struct {
+	heap BinaryHeap[stableEntry[T]]
+	// nextSeq is the sequence number of the next pushed value
+	nextSeq uint64
+}
+
+func NewStable[T any](cmp compare.CompareFunc[T]) *Stable[T] {
+	s := &Stable[T]{}
+	s.heap = *New(func(a, b stableEntry[T]) compare.Result {
+		if res := cmp(a.val, b.val); res != compare.Equal {
+			return res
+		}
+		// The earlier value is greater so it is popped first
+		switch {
+		case a.seq < b.seq:
+			return compare.Greater
+		case a.seq > b.seq:
+			return compare.Less
+		default:
+			return compare.Equal
+		}
+	})
+	return s
+}
+
+func (s *Stable[T]) Len() int {
+	return s.heap.Len()
+}
+
+func (s *Stable[T]) Push(val T) {
+	s.heap.Push(stableEntry[T]{seq: s.nextSeq, val: val})
+	s.nextSeq++
+}
+
+func (s *Stable[T]) Pop() *T {
+	popped := s.heap.Pop()
+	if popped == nil {
+		return nil
+	}
+	return &popped.val
+}
+
+func (s *Stable[T]) Peek() *T {
+	peeked := s.heap.Peek()
+	if peeked == nil {
+		return nil
+	}
+	return &peeked.val
+}
@@ -0,0 +1,72 @@
+package binaryheap
+
+import (
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/camdencheek/datastructures/compare"
+)
+
+type event struct {
+	priority int
+	id       int
+}
+
+func compareEvents(a, b event) compare.Result {
+	return CompareInts(a.priority, b.priority)
+}
+
+func TestStable(t *testing.T) {
+	t.Run("equal priorities pop in push order", func(t *testing.T) {
+		s := NewStable(compareEvents)
+		s.Push(event{1, 0})
+		s.Push(event{2, 1})
+		s.Push(event{1, 2})
+		s.Push(event{2, 3})
+		s.Push(event{1, 4})
+		require.Equal(t, event{2, 1}, *s.Peek())
+		for _, id := range []int{1, 3, 0, 2, 4} {
+			require.Equal(t, id, s.Pop().id)
+		}
+		require.Nil(t, s.Pop())
+		require.Nil(t, s.Peek())
+	})
+
+	t.Run("inverted ordering is still FIFO among equals", func(t *testing.T) {
+		s := NewStable(compare.CompareFunc[event](compareEvents).Invert())
+		s.Push(event{2, 0})
+		s.Push(event{1, 1})
+		s.Push(event{2, 2})
+		s.Push(event{1, 3})
+		for _, id := range []int{1, 3, 0, 2} {
+			require.Equal(t, id, s.Pop().id)
+		}
+	})
+
+	t.Run("quick check pops are FIFO within each priority", func(t *testing.T) {
+		f := func(priorities []uint8, pops []bool) bool {
+			s := NewStable(compareEvents)
+			lastID := make(map[int]int)
+			check := func(e event) bool {
+				last, ok := lastID[e.priority]
+				lastID[e.priority] = e.id
+				return !ok || last < e.id
+			}
+			for i, p := range priorities {
+				s.Push(event{int(p % 4), i})
+				if i < len(pops) && pops[i] && !check(*s.Pop()) {
+					return false
+				}
+			}
+			for s.Len() > 0 {
+				if !check(*s.Pop()) {
+					return false
+				}
+			}
+			return true
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+}
@@ -0,0 +1,97 @@
+package compare
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Ordered returns a CompareFunc that compares values using the built-in
+// ordering operators. For floating point types, every comparison involving
+// NaN reports Equal, which is not a consistent ordering. Use Float instead
+// if NaN values are possible.
+func Ordered[T constraints.Ordered]() CompareFunc[T] {
+	return func(a, b T) Result {
+		switch {
+		case a < b:
+			return Less
+		case a > b:
+			return Greater
+		default:
+			return Equal
+		}
+	}
+}
+
+// Float returns a CompareFunc for floating point values that orders NaN
+// before all other values, including negative infinity, and treats all NaN
+// values as equal to each other.
+func Float[T constraints.Float]() CompareFunc[T] {
+	return func(a, b T) Result {
+		aNaN, bNaN := a != a, b != b
+		switch {
+		case aNaN && bNaN:
+			return Equal
+		case aNaN:
+			return Less
+		case bNaN:
+			return Greater
+		case a < b:
+			return Less
+		case a > b:
+			return Greater
+		default:
+			return Equal
+		}
+	}
+}
+
+// Reverse returns a CompareFunc that orders values in the opposite order of
+// f. It is equivalent to f.Invert().
+func Reverse[T any](f CompareFunc[T]) CompareFunc[T] {
+	return f.Invert()
+}
+
+// By returns a CompareFunc that orders values by the key extracted from each
+// value.
+func By[T any, K constraints.Ordered](key func(T) K) CompareFunc[T] {
+	return ByFunc(key, Ordered[K]())
+}
+
+// ByFunc returns a CompareFunc that orders values by the key extracted from
+// each value, comparing keys with cmp.
+func ByFunc[T, K any](key func(T) K, cmp CompareFunc[K]) CompareFunc[T] {
+	return func(a, b T) Result {
+		return cmp(key(a), key(b))
+	}
+}
+
+// Then returns a CompareFunc that orders values by first, and orders values
+// that first considers equal by second.
+func Then[T any](first, second CompareFunc[T]) CompareFunc[T] {
+	return func(a, b T) Result {
+		if res := first(a, b); res != Equal {
+			return res
+		}
+		return second(a, b)
+	}
+}
+
+// Slice returns a CompareFunc that orders slices lexicographically, comparing
+// elements with cmp. If one slice is a prefix of the other, the shorter slice
+// is less.
+func Slice[T any](cmp CompareFunc[T]) CompareFunc[[]T] {
+	return func(a, b []T) Result {
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if res := cmp(a[i], b[i]); res != Equal {
+				return res
+			}
+		}
+		switch {
+		case len(a) < len(b):
+			return Less
+		case len(a) > len(b):
+			return Greater
+		default:
+			return Equal
+		}
+	}
+}
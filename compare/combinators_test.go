@@ -0,0 +1,59 @@
+package compare
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrdered(t *testing.T) {
+	ints := Ordered[int]()
+	require.Equal(t, Less, ints(1, 2))
+	require.Equal(t, Greater, ints(2, 1))
+	require.Equal(t, Equal, ints(2, 2))
+
+	strings := Ordered[string]()
+	require.Equal(t, Less, strings("a", "b"))
+	require.Equal(t, Greater, Reverse(strings)("a", "b"))
+}
+
+func TestFloat(t *testing.T) {
+	cmp := Float[float64]()
+	nan := math.NaN()
+	require.Equal(t, Equal, cmp(nan, nan))
+	require.Equal(t, Less, cmp(nan, math.Inf(-1)))
+	require.Equal(t, Greater, cmp(0, nan))
+	require.Equal(t, Less, cmp(1, 2))
+	require.Equal(t, Equal, cmp(0, math.Copysign(0, -1)))
+
+	vals := []float64{3, nan, -1, math.Inf(1), nan, 0}
+	sort.Slice(vals, func(i, j int) bool { return cmp.Less(vals[i], vals[j]) })
+	require.True(t, math.IsNaN(vals[0]))
+	require.True(t, math.IsNaN(vals[1]))
+	require.Equal(t, []float64{-1, 0, 3, math.Inf(1)}, vals[2:])
+}
+
+func TestBy(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	byAge := By(func(p person) int { return p.age })
+	byName := ByFunc(func(p person) string { return p.name }, Ordered[string]())
+
+	people := []person{{"carol", 30}, {"alice", 30}, {"bob", 25}}
+	cmp := Then(byAge, byName)
+	sort.Slice(people, func(i, j int) bool { return cmp.Less(people[i], people[j]) })
+	require.Equal(t, []person{{"bob", 25}, {"alice", 30}, {"carol", 30}}, people)
+}
+
+func TestSlice(t *testing.T) {
+	cmp := Slice(Ordered[int]())
+	require.Equal(t, Equal, cmp(nil, []int{}))
+	require.Equal(t, Less, cmp([]int{1, 2}, []int{1, 3}))
+	require.Equal(t, Less, cmp([]int{1, 2}, []int{1, 2, 0}))
+	require.Equal(t, Greater, cmp([]int{2}, []int{1, 9, 9}))
+	require.Equal(t, Equal, cmp([]int{1, 2}, []int{1, 2}))
+}
@@ -19,7 +19,7 @@ func (f CompareFunc[T]) Invert() CompareFunc[T] {
 		switch f(a, b) {
 		case Less:
 			return Greater
-		case Greater: 
+		case Greater:
 			return Less
 		case Equal:
 			return Equal
@@ -32,7 +32,7 @@ func (f CompareFunc[T]) Invert() CompareFunc[T] {
 type Result int8
 
 const (
-	Less Result = -1
-	Equal Result = 0
+	Less    Result = -1
+	Equal   Result = 0
 	Greater Result = 1
 )
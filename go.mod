@@ -1,10 +1,14 @@
 module github.com/camdencheek/datastructures
 
-go 1.18
+go 1.23
+
+require (
+	github.com/stretchr/testify v1.7.0
+	golang.org/x/exp v0.0.0-20220303212507-bbda1eaf7a17
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/stretchr/testify v1.7.0 // indirect
 	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
 )
@@ -0,0 +1,454 @@
+package iterator
+
+import (
+	"github.com/camdencheek/datastructures/vec"
+)
+
+// Pair holds two values yielded together by Zip or Enumerate.
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+type mapIter[T, U any] struct {
+	it  Iterator[T]
+	f   func(T) U
+	val U
+}
+
+// Map returns an iterator that yields the result of calling f on each value
+// of it.
+func Map[T, U any](it Iterator[T], f func(T) U) Iterator[U] {
+	return &mapIter[T, U]{it: it, f: f}
+}
+
+func (m *mapIter[T, U]) Next() bool {
+	if !m.it.Next() {
+		return false
+	}
+	m.val = m.f(m.it.Value())
+	return true
+}
+
+func (m *mapIter[T, U]) Value() U {
+	return m.val
+}
+
+func (m *mapIter[T, U]) SizeHint() int {
+	return SizeHint(m.it)
+}
+
+type filterIter[T any] struct {
+	it   Iterator[T]
+	pred func(T) bool
+}
+
+// Filter returns an iterator that yields only the values of it for which
+// pred returns true.
+func Filter[T any](it Iterator[T], pred func(T) bool) Iterator[T] {
+	return &filterIter[T]{it: it, pred: pred}
+}
+
+func (f *filterIter[T]) Next() bool {
+	for f.it.Next() {
+		if f.pred(f.it.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *filterIter[T]) Value() T {
+	return f.it.Value()
+}
+
+type filterMapIter[T, U any] struct {
+	it  Iterator[T]
+	f   func(T) (U, bool)
+	val U
+}
+
+// FilterMap returns an iterator that calls f on each value of it, yielding
+// the results for which f returns true.
+func FilterMap[T, U any](it Iterator[T], f func(T) (U, bool)) Iterator[U] {
+	return &filterMapIter[T, U]{it: it, f: f}
+}
+
+func (f *filterMapIter[T, U]) Next() bool {
+	for f.it.Next() {
+		if val, ok := f.f(f.it.Value()); ok {
+			f.val = val
+			return true
+		}
+	}
+	return false
+}
+
+func (f *filterMapIter[T, U]) Value() U {
+	return f.val
+}
+
+type takeIter[T any] struct {
+	it        Iterator[T]
+	remaining int
+}
+
+// Take returns an iterator that yields at most the first n values of it.
+func Take[T any](it Iterator[T], n int) Iterator[T] {
+	return &takeIter[T]{it: it, remaining: n}
+}
+
+func (t *takeIter[T]) Next() bool {
+	if t.remaining <= 0 {
+		return false
+	}
+	t.remaining--
+	return t.it.Next()
+}
+
+func (t *takeIter[T]) Value() T {
+	return t.it.Value()
+}
+
+func (t *takeIter[T]) SizeHint() int {
+	return max(0, min(t.remaining, SizeHint(t.it)))
+}
+
+type skipIter[T any] struct {
+	it Iterator[T]
+	n  int
+}
+
+// Skip returns an iterator that yields all but the first n values of it.
+func Skip[T any](it Iterator[T], n int) Iterator[T] {
+	return &skipIter[T]{it: it, n: n}
+}
+
+func (s *skipIter[T]) Next() bool {
+	for ; s.n > 0; s.n-- {
+		if !s.it.Next() {
+			s.n = 0
+			return false
+		}
+	}
+	return s.it.Next()
+}
+
+func (s *skipIter[T]) Value() T {
+	return s.it.Value()
+}
+
+func (s *skipIter[T]) SizeHint() int {
+	return max(0, SizeHint(s.it)-s.n)
+}
+
+type takeWhileIter[T any] struct {
+	it   Iterator[T]
+	pred func(T) bool
+	done bool
+}
+
+// TakeWhile returns an iterator that yields values of it until pred first
+// returns false.
+func TakeWhile[T any](it Iterator[T], pred func(T) bool) Iterator[T] {
+	return &takeWhileIter[T]{it: it, pred: pred}
+}
+
+func (t *takeWhileIter[T]) Next() bool {
+	if t.done {
+		return false
+	}
+	if !t.it.Next() || !t.pred(t.it.Value()) {
+		t.done = true
+		return false
+	}
+	return true
+}
+
+func (t *takeWhileIter[T]) Value() T {
+	return t.it.Value()
+}
+
+type skipWhileIter[T any] struct {
+	it       Iterator[T]
+	pred     func(T) bool
+	skipping bool
+}
+
+// SkipWhile returns an iterator that skips values of it until pred first
+// returns false, then yields all remaining values.
+func SkipWhile[T any](it Iterator[T], pred func(T) bool) Iterator[T] {
+	return &skipWhileIter[T]{it: it, pred: pred, skipping: true}
+}
+
+func (s *skipWhileIter[T]) Next() bool {
+	if !s.skipping {
+		return s.it.Next()
+	}
+	s.skipping = false
+	for s.it.Next() {
+		if !s.pred(s.it.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *skipWhileIter[T]) Value() T {
+	return s.it.Value()
+}
+
+type chainIter[T any] struct {
+	its []Iterator[T]
+}
+
+// Chain returns an iterator that yields all the values of each iterator in
+// turn.
+func Chain[T any](its ...Iterator[T]) Iterator[T] {
+	return &chainIter[T]{its: its}
+}
+
+func (c *chainIter[T]) Next() bool {
+	for len(c.its) > 0 {
+		if c.its[0].Next() {
+			return true
+		}
+		c.its = c.its[1:]
+	}
+	return false
+}
+
+func (c *chainIter[T]) Value() T {
+	return c.its[0].Value()
+}
+
+func (c *chainIter[T]) SizeHint() int {
+	total := 0
+	for _, it := range c.its {
+		total += SizeHint(it)
+	}
+	return total
+}
+
+type zipIter[T, U any] struct {
+	a Iterator[T]
+	b Iterator[U]
+}
+
+// Zip returns an iterator that yields pairs of values from a and b, stopping
+// when either is exhausted.
+func Zip[T, U any](a Iterator[T], b Iterator[U]) Iterator[Pair[T, U]] {
+	return &zipIter[T, U]{a: a, b: b}
+}
+
+func (z *zipIter[T, U]) Next() bool {
+	return z.a.Next() && z.b.Next()
+}
+
+func (z *zipIter[T, U]) Value() Pair[T, U] {
+	return Pair[T, U]{First: z.a.Value(), Second: z.b.Value()}
+}
+
+func (z *zipIter[T, U]) SizeHint() int {
+	return min(SizeHint(z.a), SizeHint(z.b))
+}
+
+type enumerateIter[T any] struct {
+	it    Iterator[T]
+	index int
+}
+
+// Enumerate returns an iterator that yields each value of it paired with its
+// index, starting from zero.
+func Enumerate[T any](it Iterator[T]) Iterator[Pair[int, T]] {
+	return &enumerateIter[T]{it: it, index: -1}
+}
+
+func (e *enumerateIter[T]) Next() bool {
+	if !e.it.Next() {
+		return false
+	}
+	e.index++
+	return true
+}
+
+func (e *enumerateIter[T]) Value() Pair[int, T] {
+	return Pair[int, T]{First: e.index, Second: e.it.Value()}
+}
+
+func (e *enumerateIter[T]) SizeHint() int {
+	return SizeHint(e.it)
+}
+
+type flattenIter[T any] struct {
+	outer Iterator[Iterator[T]]
+	inner Iterator[T]
+}
+
+// Flatten returns an iterator that yields all the values of each iterator
+// yielded by it in turn.
+func Flatten[T any](it Iterator[Iterator[T]]) Iterator[T] {
+	return &flattenIter[T]{outer: it}
+}
+
+func (f *flattenIter[T]) Next() bool {
+	for {
+		if f.inner != nil && f.inner.Next() {
+			return true
+		}
+		if !f.outer.Next() {
+			f.inner = nil
+			return false
+		}
+		f.inner = f.outer.Value()
+	}
+}
+
+func (f *flattenIter[T]) Value() T {
+	return f.inner.Value()
+}
+
+type stepByIter[T any] struct {
+	it      Iterator[T]
+	step    int
+	started bool
+}
+
+// StepBy returns an iterator that yields the first value of it, then every
+// step-th value after that. StepBy panics if step is less than one.
+func StepBy[T any](it Iterator[T], step int) Iterator[T] {
+	if step < 1 {
+		panic("iterator: step must be positive")
+	}
+	return &stepByIter[T]{it: it, step: step}
+}
+
+func (s *stepByIter[T]) Next() bool {
+	if !s.started {
+		s.started = true
+		return s.it.Next()
+	}
+	for i := 0; i < s.step; i++ {
+		if !s.it.Next() {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *stepByIter[T]) Value() T {
+	return s.it.Value()
+}
+
+type chunksIter[T any] struct {
+	it    Iterator[T]
+	size  int
+	chunk vec.Vec[T]
+}
+
+// Chunks returns an iterator that yields consecutive, non-overlapping chunks
+// of size values from it. The last chunk may be shorter. Each chunk is a new
+// vec. Chunks panics if size is less than one.
+func Chunks[T any](it Iterator[T], size int) Iterator[vec.Vec[T]] {
+	if size < 1 {
+		panic("iterator: chunk size must be positive")
+	}
+	return &chunksIter[T]{it: it, size: size}
+}
+
+func (c *chunksIter[T]) Next() bool {
+	c.chunk = nil
+	for c.chunk.Len() < c.size && c.it.Next() {
+		if c.chunk == nil {
+			c.chunk = make(vec.Vec[T], 0, c.size)
+		}
+		c.chunk.Push(c.it.Value())
+	}
+	return c.chunk.Len() > 0
+}
+
+func (c *chunksIter[T]) Value() vec.Vec[T] {
+	return c.chunk
+}
+
+type windowsIter[T any] struct {
+	it     Iterator[T]
+	size   int
+	window vec.Vec[T]
+}
+
+// Windows returns an iterator that yields every overlapping window of size
+// consecutive values from it. If it yields fewer than size values, there are
+// no windows. Each window is a new vec. Windows panics if size is less than
+// one.
+func Windows[T any](it Iterator[T], size int) Iterator[vec.Vec[T]] {
+	if size < 1 {
+		panic("iterator: window size must be positive")
+	}
+	return &windowsIter[T]{it: it, size: size}
+}
+
+func (w *windowsIter[T]) Next() bool {
+	if w.window == nil {
+		window := make(vec.Vec[T], 0, w.size)
+		for window.Len() < w.size {
+			if !w.it.Next() {
+				return false
+			}
+			window.Push(w.it.Value())
+		}
+		w.window = window
+		return true
+	}
+
+	if !w.it.Next() {
+		return false
+	}
+	window := make(vec.Vec[T], 0, w.size)
+	window.AppendSlice(w.window[1:])
+	window.Push(w.it.Value())
+	w.window = window
+	return true
+}
+
+func (w *windowsIter[T]) Value() vec.Vec[T] {
+	return w.window
+}
+
+type revIter[T any] struct {
+	it DoubleEnded[T]
+}
+
+// Rev returns an iterator that yields the values of a double-ended iterator
+// from back to front. If it implements ExactSize, so does the result.
+func Rev[T any](it DoubleEnded[T]) DoubleEnded[T] {
+	if exact, ok := it.(ExactSize[T]); ok {
+		return &exactRevIter[T]{revIter[T]{it: it}, exact}
+	}
+	return &revIter[T]{it: it}
+}
+
+func (r *revIter[T]) Next() bool {
+	return r.it.NextBack()
+}
+
+func (r *revIter[T]) NextBack() bool {
+	return r.it.Next()
+}
+
+func (r *revIter[T]) Value() T {
+	return r.it.Value()
+}
+
+func (r *revIter[T]) SizeHint() int {
+	return SizeHint[T](r.it)
+}
+
+type exactRevIter[T any] struct {
+	revIter[T]
+	exact ExactSize[T]
+}
+
+func (r *exactRevIter[T]) Len() int {
+	return r.exact.Len()
+}
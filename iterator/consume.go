@@ -0,0 +1,107 @@
+package iterator
+
+import (
+	"github.com/camdencheek/datastructures/compare"
+	"github.com/camdencheek/datastructures/vec"
+)
+
+// Collect consumes the iterator and returns its values in a vec. If the
+// iterator provides a size hint, the vec is allocated up front.
+func Collect[T any](it Iterator[T]) vec.Vec[T] {
+	var res vec.Vec[T]
+	if hint := SizeHint(it); hint > 0 {
+		res = make(vec.Vec[T], 0, hint)
+	}
+	for it.Next() {
+		res.Push(it.Value())
+	}
+	return res
+}
+
+// ForEach consumes the iterator, calling f on each value.
+func ForEach[T any](it Iterator[T], f func(T)) {
+	for it.Next() {
+		f(it.Value())
+	}
+}
+
+// Fold consumes the iterator, combining each value into an accumulator that
+// starts as init, and returns the final accumulator.
+func Fold[T, A any](it Iterator[T], init A, f func(A, T) A) A {
+	acc := init
+	for it.Next() {
+		acc = f(acc, it.Value())
+	}
+	return acc
+}
+
+// Reduce consumes the iterator, combining the values using f with the first
+// value as the initial accumulator. It returns nil if the iterator is empty.
+func Reduce[T any](it Iterator[T], f func(T, T) T) *T {
+	if !it.Next() {
+		return nil
+	}
+	acc := Fold(it, it.Value(), f)
+	return &acc
+}
+
+// Count consumes the iterator and returns the number of values it yielded.
+func Count[T any](it Iterator[T]) int {
+	count := 0
+	for it.Next() {
+		count++
+	}
+	return count
+}
+
+// Any returns whether pred returns true for any value of the iterator. It
+// stops consuming the iterator at the first such value.
+func Any[T any](it Iterator[T], pred func(T) bool) bool {
+	return Find(it, pred) != nil
+}
+
+// All returns whether pred returns true for every value of the iterator. It
+// stops consuming the iterator at the first value for which it does not.
+func All[T any](it Iterator[T], pred func(T) bool) bool {
+	for it.Next() {
+		if !pred(it.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find returns the first value of the iterator for which pred returns true,
+// or nil if there is none. It stops consuming the iterator at that value.
+func Find[T any](it Iterator[T], pred func(T) bool) *T {
+	for it.Next() {
+		if val := it.Value(); pred(val) {
+			return &val
+		}
+	}
+	return nil
+}
+
+// Min consumes the iterator and returns its least value, or nil if the
+// iterator is empty. If several values are equally least, the first is
+// returned.
+func Min[T any](it Iterator[T], cmp compare.CompareFunc[T]) *T {
+	return Reduce(it, func(min, val T) T {
+		if cmp.Less(val, min) {
+			return val
+		}
+		return min
+	})
+}
+
+// Max consumes the iterator and returns its greatest value, or nil if the
+// iterator is empty. If several values are equally greatest, the last is
+// returned.
+func Max[T any](it Iterator[T], cmp compare.CompareFunc[T]) *T {
+	return Reduce(it, func(max, val T) T {
+		if cmp.Less(val, max) {
+			return max
+		}
+		return val
+	})
+}
@@ -0,0 +1,177 @@
+package iterator
+
+import (
+	"github.com/camdencheek/datastructures/vec"
+)
+
+// ErrIterator is an Iterator that can fail. When Next returns false, the
+// caller should check Err, which returns the error that stopped the
+// iterator, or nil if the iterator was simply exhausted. This mirrors
+// bufio.Scanner.
+type ErrIterator[T any] interface {
+	Iterator[T]
+	Err() error
+}
+
+type infallible[T any] struct {
+	Iterator[T]
+}
+
+func (infallible[T]) Err() error {
+	return nil
+}
+
+func (i infallible[T]) SizeHint() int {
+	return SizeHint(i.Iterator)
+}
+
+type exactInfallible[T any] struct {
+	infallible[T]
+	exact ExactSize[T]
+}
+
+func (e exactInfallible[T]) Len() int {
+	return e.exact.Len()
+}
+
+// Fallible returns an ErrIterator that yields the values of it and never
+// fails. It keeps the size hint of it, and implements ExactSize if it does.
+func Fallible[T any](it Iterator[T]) ErrIterator[T] {
+	if exact, ok := it.(ExactSize[T]); ok {
+		return exactInfallible[T]{infallible[T]{it}, exact}
+	}
+	return infallible[T]{it}
+}
+
+type adapted[U any] struct {
+	Iterator[U]
+	err func() error
+}
+
+func (a *adapted[U]) Err() error {
+	return a.err()
+}
+
+// Adapt applies an adapter for ordinary iterators to an ErrIterator,
+// returning an ErrIterator that reports the errors of it. For example:
+//
+//	evens := Adapt(rows, func(it Iterator[int]) Iterator[int] {
+//		return StepBy(it, 2)
+//	})
+func Adapt[T, U any](it ErrIterator[T], adapter func(Iterator[T]) Iterator[U]) ErrIterator[U] {
+	return &adapted[U]{Iterator: adapter(it), err: it.Err}
+}
+
+// MapErr is like Map, but for an ErrIterator.
+func MapErr[T, U any](it ErrIterator[T], f func(T) U) ErrIterator[U] {
+	return Adapt(it, func(it Iterator[T]) Iterator[U] { return Map(it, f) })
+}
+
+// FilterErr is like Filter, but for an ErrIterator.
+func FilterErr[T any](it ErrIterator[T], pred func(T) bool) ErrIterator[T] {
+	return Adapt(it, func(it Iterator[T]) Iterator[T] { return Filter(it, pred) })
+}
+
+type tryMapIter[T, U any] struct {
+	it  ErrIterator[T]
+	f   func(T) (U, error)
+	val U
+	err error
+}
+
+// TryMap returns an iterator that yields the result of calling f on each
+// value of it. It stops at the first error returned by f or by it.
+func TryMap[T, U any](it ErrIterator[T], f func(T) (U, error)) ErrIterator[U] {
+	return &tryMapIter[T, U]{it: it, f: f}
+}
+
+func (t *tryMapIter[T, U]) Next() bool {
+	if t.err != nil || !t.it.Next() {
+		return false
+	}
+	t.val, t.err = t.f(t.it.Value())
+	return t.err == nil
+}
+
+func (t *tryMapIter[T, U]) Value() U {
+	return t.val
+}
+
+func (t *tryMapIter[T, U]) Err() error {
+	if t.err != nil {
+		return t.err
+	}
+	return t.it.Err()
+}
+
+type tryFilterIter[T any] struct {
+	it   ErrIterator[T]
+	pred func(T) (bool, error)
+	err  error
+}
+
+// TryFilter returns an iterator that yields only the values of it for which
+// pred returns true. It stops at the first error returned by pred or by it.
+func TryFilter[T any](it ErrIterator[T], pred func(T) (bool, error)) ErrIterator[T] {
+	return &tryFilterIter[T]{it: it, pred: pred}
+}
+
+func (t *tryFilterIter[T]) Next() bool {
+	if t.err != nil {
+		return false
+	}
+	for t.it.Next() {
+		var ok bool
+		ok, t.err = t.pred(t.it.Value())
+		if t.err != nil {
+			return false
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *tryFilterIter[T]) Value() T {
+	return t.it.Value()
+}
+
+func (t *tryFilterIter[T]) Err() error {
+	if t.err != nil {
+		return t.err
+	}
+	return t.it.Err()
+}
+
+// CollectErr consumes the iterator and returns its values in a vec. If the
+// iterator fails, it returns the values collected so far along with the
+// error.
+func CollectErr[T any](it ErrIterator[T]) (vec.Vec[T], error) {
+	res := Collect[T](it)
+	return res, it.Err()
+}
+
+// FoldErr is like Fold, but for an ErrIterator. It stops at the first error
+// returned by f or by it.
+func FoldErr[T, A any](it ErrIterator[T], init A, f func(A, T) (A, error)) (A, error) {
+	acc := init
+	for it.Next() {
+		var err error
+		if acc, err = f(acc, it.Value()); err != nil {
+			return acc, err
+		}
+	}
+	return acc, it.Err()
+}
+
+// ForEachErr consumes the iterator, calling f on each value. It stops at the
+// first error returned by f or by it.
+func ForEachErr[T any](it ErrIterator[T], f func(T) error) error {
+	for it.Next() {
+		if err := f(it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
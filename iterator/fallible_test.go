@@ -0,0 +1,137 @@
+package iterator
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/camdencheek/datastructures/vec"
+)
+
+var errBroken = errors.New("broken")
+
+// failing yields the values of vals, then fails with err.
+type failing struct {
+	vals []int
+	err  error
+	val  int
+	done bool
+}
+
+func (f *failing) Next() bool {
+	if len(f.vals) == 0 {
+		f.done = true
+		return false
+	}
+	f.val, f.vals = f.vals[0], f.vals[1:]
+	return true
+}
+
+func (f *failing) Value() int {
+	return f.val
+}
+
+func (f *failing) Err() error {
+	if f.done {
+		return f.err
+	}
+	return nil
+}
+
+func TestErrIterator(t *testing.T) {
+	t.Run("Fallible", func(t *testing.T) {
+		res, err := CollectErr(Fallible(sliceIter(1, 2)))
+		require.NoError(t, err)
+		require.Equal(t, vec.New(1, 2), res)
+	})
+
+	t.Run("Fallible keeps size hints", func(t *testing.T) {
+		it := Fallible(FromSlice(make([]int, 5)))
+		require.Equal(t, 5, it.(ExactSize[int]).Len())
+		res, err := CollectErr(it)
+		require.NoError(t, err)
+		require.Equal(t, 5, res.Cap())
+
+		hinted := Fallible(Take(FromSlice(make([]int, 5)), 3))
+		require.Equal(t, 3, SizeHint(hinted))
+		_, exact := hinted.(ExactSize[int])
+		require.False(t, exact)
+	})
+
+	t.Run("CollectErr", func(t *testing.T) {
+		res, err := CollectErr[int](&failing{vals: []int{1, 2}, err: errBroken})
+		require.ErrorIs(t, err, errBroken)
+		require.Equal(t, vec.New(1, 2), res)
+	})
+
+	t.Run("MapErr FilterErr carry the error", func(t *testing.T) {
+		src := &failing{vals: []int{1, 2, 3, 4}, err: errBroken}
+		it := MapErr(FilterErr[int](src, isEven), strconv.Itoa)
+		res, err := CollectErr(it)
+		require.ErrorIs(t, err, errBroken)
+		require.Equal(t, vec.New("2", "4"), res)
+	})
+
+	t.Run("Adapt", func(t *testing.T) {
+		src := &failing{vals: []int{1, 2, 3}, err: errBroken}
+		it := Adapt[int](src, func(it Iterator[int]) Iterator[Pair[int, int]] { return Enumerate(it) })
+		res, err := CollectErr(it)
+		require.ErrorIs(t, err, errBroken)
+		require.Equal(t, 3, res.Len())
+
+		// Stopping before the source is exhausted is not an error
+		src = &failing{vals: []int{1, 2, 3}, err: errBroken}
+		res2, err := CollectErr(Adapt[int](src, func(it Iterator[int]) Iterator[int] { return Take(it, 2) }))
+		require.NoError(t, err)
+		require.Equal(t, vec.New(1, 2), res2)
+	})
+
+	t.Run("TryMap", func(t *testing.T) {
+		it := TryMap(Fallible(FromSlice([]string{"1", "x", "3"})), strconv.Atoi)
+		res, err := CollectErr(it)
+		require.ErrorIs(t, err, strconv.ErrSyntax)
+		require.Equal(t, vec.New(1), res)
+		require.False(t, it.Next())
+
+		res, err = CollectErr(TryMap(Fallible(FromSlice([]string{"1", "2"})), strconv.Atoi))
+		require.NoError(t, err)
+		require.Equal(t, vec.New(1, 2), res)
+	})
+
+	t.Run("TryFilter", func(t *testing.T) {
+		pred := func(i int) (bool, error) {
+			if i > 3 {
+				return false, errBroken
+			}
+			return isEven(i), nil
+		}
+		res, err := CollectErr(TryFilter(Fallible(sliceIter(1, 2, 3, 4, 6)), pred))
+		require.ErrorIs(t, err, errBroken)
+		require.Equal(t, vec.New(2), res)
+	})
+
+	t.Run("FoldErr", func(t *testing.T) {
+		add := func(acc, i int) (int, error) { return acc + i, nil }
+		sum, err := FoldErr(Fallible(sliceIter(1, 2, 3)), 0, add)
+		require.NoError(t, err)
+		require.Equal(t, 6, sum)
+
+		_, err = FoldErr[int](&failing{vals: []int{1}, err: errBroken}, 0, add)
+		require.ErrorIs(t, err, errBroken)
+	})
+
+	t.Run("ForEachErr", func(t *testing.T) {
+		var seen []int
+		err := ForEachErr(Fallible(sliceIter(1, 2, 3)), func(i int) error {
+			seen = append(seen, i)
+			if i == 2 {
+				return errBroken
+			}
+			return nil
+		})
+		require.ErrorIs(t, err, errBroken)
+		require.Equal(t, []int{1, 2}, seen)
+	})
+}
@@ -1,6 +1,59 @@
 package iterator
 
+import (
+	"github.com/camdencheek/datastructures/vec"
+)
+
+// Iterator yields a sequence of values. Next advances the iterator and
+// reports whether there is a value to read, and Value returns that value.
+// Once Next returns false, it continues to return false.
 type Iterator[T any] interface {
 	Next() bool
 	Value() T
 }
+
+// DoubleEnded is an Iterator that can also yield values from the back.
+// NextBack advances the back of the iterator, after which Value returns the
+// value at the back. The front and back never pass each other, so each
+// value is yielded at most once.
+type DoubleEnded[T any] interface {
+	Iterator[T]
+	NextBack() bool
+}
+
+// SizeHinted is an Iterator that can estimate how many values it has left.
+// SizeHint returns a lower bound on the number of remaining values.
+type SizeHinted[T any] interface {
+	Iterator[T]
+	SizeHint() int
+}
+
+// ExactSize is an Iterator that knows exactly how many values it has left.
+type ExactSize[T any] interface {
+	Iterator[T]
+	Len() int
+}
+
+// SizeHint returns a lower bound on the number of values the iterator has
+// left, using Len or SizeHint if the iterator implements either. Otherwise,
+// it returns zero.
+func SizeHint[T any](it Iterator[T]) int {
+	switch it := it.(type) {
+	case ExactSize[T]:
+		return it.Len()
+	case SizeHinted[T]:
+		return it.SizeHint()
+	default:
+		return 0
+	}
+}
+
+// FromSlice returns an iterator over the values of the slice.
+func FromSlice[T any](s []T) Iterator[T] {
+	return vec.NewFromSlice(s).Iter()
+}
+
+// FromVec returns an iterator over the values of the vec.
+func FromVec[T any](v vec.Vec[T]) Iterator[T] {
+	return v.Iter()
+}
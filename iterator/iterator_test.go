@@ -0,0 +1,211 @@
+package iterator
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/camdencheek/datastructures/compare"
+	"github.com/camdencheek/datastructures/vec"
+)
+
+func sliceIter(vals ...int) Iterator[int] {
+	return FromSlice(vals)
+}
+
+func isEven(i int) bool {
+	return i%2 == 0
+}
+
+func TestAdapters(t *testing.T) {
+	t.Run("Map", func(t *testing.T) {
+		res := Collect(Map(sliceIter(1, 2, 3), strconv.Itoa))
+		require.Equal(t, vec.New("1", "2", "3"), res)
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		require.Equal(t, vec.New(2, 4), Collect(Filter(sliceIter(1, 2, 3, 4, 5), isEven)))
+		require.Nil(t, Collect(Filter(sliceIter(1, 3), isEven)))
+	})
+
+	t.Run("FilterMap", func(t *testing.T) {
+		parse := func(s string) (int, bool) {
+			i, err := strconv.Atoi(s)
+			return i, err == nil
+		}
+		it := FromSlice([]string{"1", "x", "3"})
+		require.Equal(t, vec.New(1, 3), Collect(FilterMap(it, parse)))
+	})
+
+	t.Run("Take", func(t *testing.T) {
+		require.Equal(t, vec.New(1, 2), Collect(Take(sliceIter(1, 2, 3), 2)))
+		require.Equal(t, vec.New(1, 2), Collect(Take(sliceIter(1, 2), 5)))
+		require.Nil(t, Collect(Take(sliceIter(1, 2), 0)))
+	})
+
+	t.Run("Skip", func(t *testing.T) {
+		require.Equal(t, vec.New(3), Collect(Skip(sliceIter(1, 2, 3), 2)))
+		require.Nil(t, Collect(Skip(sliceIter(1, 2), 5)))
+	})
+
+	t.Run("TakeWhile", func(t *testing.T) {
+		it := TakeWhile(sliceIter(2, 4, 5, 6), isEven)
+		require.Equal(t, vec.New(2, 4), Collect(it))
+		require.False(t, it.Next())
+	})
+
+	t.Run("SkipWhile", func(t *testing.T) {
+		require.Equal(t, vec.New(5, 6), Collect(SkipWhile(sliceIter(2, 4, 5, 6), isEven)))
+		require.Nil(t, Collect(SkipWhile(sliceIter(2, 4), isEven)))
+	})
+
+	t.Run("Chain", func(t *testing.T) {
+		require.Equal(t, vec.New(1, 2, 3), Collect(Chain(sliceIter(1), sliceIter(), sliceIter(2, 3))))
+		require.Nil(t, Collect(Chain[int]()))
+	})
+
+	t.Run("Zip", func(t *testing.T) {
+		res := Collect(Zip(sliceIter(1, 2, 3), FromSlice([]string{"a", "b"})))
+		require.Equal(t, vec.New(Pair[int, string]{1, "a"}, Pair[int, string]{2, "b"}), res)
+	})
+
+	t.Run("Enumerate", func(t *testing.T) {
+		res := Collect(Enumerate(FromSlice([]string{"a", "b"})))
+		require.Equal(t, vec.New(Pair[int, string]{0, "a"}, Pair[int, string]{1, "b"}), res)
+	})
+
+	t.Run("Flatten", func(t *testing.T) {
+		its := FromSlice([]Iterator[int]{sliceIter(1, 2), sliceIter(), sliceIter(3)})
+		require.Equal(t, vec.New(1, 2, 3), Collect(Flatten(its)))
+	})
+
+	t.Run("StepBy", func(t *testing.T) {
+		require.Equal(t, vec.New(1, 4, 7), Collect(StepBy(sliceIter(1, 2, 3, 4, 5, 6, 7, 8), 3)))
+		require.Equal(t, vec.New(1, 2), Collect(StepBy(sliceIter(1, 2), 1)))
+		require.Panics(t, func() { StepBy(sliceIter(), 0) })
+	})
+
+	t.Run("Chunks", func(t *testing.T) {
+		res := Collect(Chunks(sliceIter(1, 2, 3, 4, 5), 2))
+		require.Equal(t, vec.New(vec.New(1, 2), vec.New(3, 4), vec.New(5)), res)
+		require.Nil(t, Collect(Chunks(sliceIter(), 2)))
+	})
+
+	t.Run("vec Drain", func(t *testing.T) {
+		v := vec.New(1, 2, 3, 4)
+		require.Equal(t, vec.New(2, 3), Collect[int](v.Drain(1, 3)))
+		require.Equal(t, vec.New(1, 4), v)
+	})
+
+	t.Run("Windows", func(t *testing.T) {
+		res := Collect(Windows(sliceIter(1, 2, 3, 4), 3))
+		require.Equal(t, vec.New(vec.New(1, 2, 3), vec.New(2, 3, 4)), res)
+		require.Nil(t, Collect(Windows(sliceIter(1, 2), 3)))
+	})
+}
+
+func TestConsumers(t *testing.T) {
+	ints := compare.Ordered[int]()
+
+	t.Run("Fold", func(t *testing.T) {
+		sum := Fold(sliceIter(1, 2, 3), 10, func(acc, i int) int { return acc + i })
+		require.Equal(t, 16, sum)
+	})
+
+	t.Run("Reduce", func(t *testing.T) {
+		add := func(a, b int) int { return a + b }
+		require.Equal(t, 6, *Reduce(sliceIter(1, 2, 3), add))
+		require.Nil(t, Reduce(sliceIter(), add))
+	})
+
+	t.Run("ForEach", func(t *testing.T) {
+		var res []int
+		ForEach(sliceIter(1, 2), func(i int) { res = append(res, i) })
+		require.Equal(t, []int{1, 2}, res)
+	})
+
+	t.Run("Count", func(t *testing.T) {
+		require.Equal(t, 3, Count(sliceIter(1, 2, 3)))
+		require.Equal(t, 0, Count(sliceIter()))
+	})
+
+	t.Run("Any", func(t *testing.T) {
+		require.True(t, Any(sliceIter(1, 2), isEven))
+		require.False(t, Any(sliceIter(1, 3), isEven))
+		require.False(t, Any(sliceIter(), isEven))
+	})
+
+	t.Run("All", func(t *testing.T) {
+		require.True(t, All(sliceIter(2, 4), isEven))
+		require.False(t, All(sliceIter(2, 3), isEven))
+		require.True(t, All(sliceIter(), isEven))
+	})
+
+	t.Run("Find", func(t *testing.T) {
+		it := sliceIter(1, 2, 3, 4)
+		require.Equal(t, 2, *Find(it, isEven))
+		require.Equal(t, 4, *Find(it, isEven))
+		require.Nil(t, Find(it, isEven))
+	})
+
+	t.Run("Min Max", func(t *testing.T) {
+		require.Equal(t, 1, *Min(sliceIter(3, 1, 2), ints))
+		require.Equal(t, 3, *Max(sliceIter(3, 1, 2), ints))
+		require.Nil(t, Min(sliceIter(), ints))
+		require.Nil(t, Max(sliceIter(), ints))
+
+		byFirst := compare.By(func(p Pair[int, int]) int { return p.First })
+		pairs := vec.New(Pair[int, int]{1, 0}, Pair[int, int]{1, 1}, Pair[int, int]{0, 2}, Pair[int, int]{0, 3})
+		require.Equal(t, 2, Min(FromVec(pairs), byFirst).Second)
+		require.Equal(t, 1, Max(FromVec(pairs), byFirst).Second)
+	})
+}
+
+func TestSizeHint(t *testing.T) {
+	t.Run("ExactSize", func(t *testing.T) {
+		it := sliceIter(1, 2, 3)
+		require.Equal(t, 3, SizeHint(it))
+		it.Next()
+		require.Equal(t, 2, SizeHint(it))
+	})
+
+	t.Run("unknown size", func(t *testing.T) {
+		require.Equal(t, 0, SizeHint(Filter(sliceIter(1, 2), isEven)))
+	})
+
+	t.Run("adapters propagate hints", func(t *testing.T) {
+		require.Equal(t, 3, SizeHint(Map(sliceIter(1, 2, 3), strconv.Itoa)))
+		require.Equal(t, 2, SizeHint(Take(sliceIter(1, 2, 3), 2)))
+		require.Equal(t, 1, SizeHint(Skip(sliceIter(1, 2, 3), 2)))
+		require.Equal(t, 0, SizeHint(Skip(sliceIter(1, 2, 3), 5)))
+		require.Equal(t, 5, SizeHint(Chain(sliceIter(1, 2, 3), sliceIter(4, 5))))
+		require.Equal(t, 2, SizeHint(Zip(sliceIter(1, 2, 3), sliceIter(4, 5))))
+		require.Equal(t, 3, SizeHint(Enumerate(sliceIter(1, 2, 3))))
+	})
+
+	t.Run("Collect allocates once", func(t *testing.T) {
+		res := Collect(Map(sliceIter(1, 2, 3), strconv.Itoa))
+		require.Equal(t, vec.New("1", "2", "3"), res)
+		require.Equal(t, 3, res.Cap())
+
+		res = Collect(Take(FromSlice(make([]string, 100)), 6))
+		require.Equal(t, 6, res.Cap())
+	})
+}
+
+func TestRev(t *testing.T) {
+	it := Rev(vec.New(1, 2, 3, 4).Iter())
+	require.Equal(t, 4, SizeHint[int](it))
+	require.True(t, it.Next())
+	require.Equal(t, 4, it.Value())
+	require.True(t, it.NextBack())
+	require.Equal(t, 1, it.Value())
+	require.Equal(t, 2, it.(ExactSize[int]).Len())
+	require.Equal(t, vec.New(3, 2), Collect[int](it))
+
+	// Hide Len so that only NextBack remains
+	hidden := struct{ DoubleEnded[int] }{vec.New(1, 2).Iter()}
+	_, exact := Rev[int](hidden).(ExactSize[int])
+	require.False(t, exact)
+}
@@ -0,0 +1,126 @@
+package iterator
+
+// Peekable wraps an Iterator with one-item lookahead and with checkpoints
+// that can rewind the iterator to an earlier position. Values are buffered
+// only while they are needed for lookahead or for an outstanding checkpoint.
+type Peekable[T any] struct {
+	it Iterator[T]
+	// pending holds values already pulled from it that have not been
+	// yielded yet, either by Peek or by Restore
+	pending []T
+	// history holds the values yielded since the oldest outstanding
+	// checkpoint
+	history []T
+	// marks is the stack of outstanding checkpoints
+	marks []mark[T]
+	val   T
+}
+
+type mark[T any] struct {
+	// pos is the length of history when the checkpoint was taken
+	pos int
+	// val is the value of the iterator when the checkpoint was taken
+	val T
+}
+
+// NewPeekable returns a Peekable that yields the values of it.
+func NewPeekable[T any](it Iterator[T]) *Peekable[T] {
+	return &Peekable[T]{it: it}
+}
+
+// Next advances the iterator and reports whether there is a value to read.
+func (p *Peekable[T]) Next() bool {
+	if len(p.pending) > 0 {
+		p.val = p.pending[0]
+		p.pending = p.pending[1:]
+	} else if p.it.Next() {
+		p.val = p.it.Value()
+	} else {
+		return false
+	}
+	if len(p.marks) > 0 {
+		p.history = append(p.history, p.val)
+	}
+	return true
+}
+
+// Value returns the value the iterator was last advanced to.
+func (p *Peekable[T]) Value() T {
+	return p.val
+}
+
+// Peek returns a pointer to the value the next call to Next would yield,
+// without advancing the iterator, or nil if the iterator is exhausted.
+func (p *Peekable[T]) Peek() *T {
+	if len(p.pending) == 0 {
+		if !p.it.Next() {
+			return nil
+		}
+		p.pending = append(p.pending, p.it.Value())
+	}
+	return &p.pending[0]
+}
+
+// NextIf advances the iterator only if the next value satisfies pred, and
+// reports whether it did.
+func (p *Peekable[T]) NextIf(pred func(T) bool) bool {
+	if next := p.Peek(); next == nil || !pred(*next) {
+		return false
+	}
+	return p.Next()
+}
+
+// Checkpoint records the current position of the iterator. Until the
+// checkpoint is released by Restore or Release, every value yielded is
+// buffered so the iterator can be rewound to this position. Checkpoints
+// nest: Restore and Release always act on the most recent one.
+func (p *Peekable[T]) Checkpoint() {
+	p.marks = append(p.marks, mark[T]{pos: len(p.history), val: p.val})
+}
+
+// Restore rewinds the iterator to the most recent checkpoint and releases
+// it, so the values yielded since are yielded again. Restore panics if there
+// is no outstanding checkpoint.
+func (p *Peekable[T]) Restore() {
+	m := p.popMark()
+	replay := make([]T, 0, len(p.history)-m.pos+len(p.pending))
+	replay = append(replay, p.history[m.pos:]...)
+	p.pending = append(replay, p.pending...)
+	p.truncate(m.pos)
+	p.val = m.val
+}
+
+// Release discards the most recent checkpoint without rewinding the
+// iterator. Release panics if there is no outstanding checkpoint.
+func (p *Peekable[T]) Release() {
+	m := p.popMark()
+	if len(p.marks) == 0 {
+		p.truncate(m.pos)
+	}
+}
+
+func (p *Peekable[T]) popMark() mark[T] {
+	if len(p.marks) == 0 {
+		panic("iterator: no outstanding checkpoint")
+	}
+	m := p.marks[len(p.marks)-1]
+	p.marks = p.marks[:len(p.marks)-1]
+	return m
+}
+
+// truncate shortens the history to n values, dropping it altogether once
+// no checkpoint needs it.
+func (p *Peekable[T]) truncate(n int) {
+	if len(p.marks) == 0 {
+		p.history = nil
+		return
+	}
+	clear(p.history[n:])
+	p.history = p.history[:n]
+}
+
+// SizeHint returns a lower bound on the number of values the iterator has
+// left.
+func (p *Peekable[T]) SizeHint() int {
+	return len(p.pending) + SizeHint(p.it)
+}
@@ -0,0 +1,100 @@
+package iterator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/camdencheek/datastructures/vec"
+)
+
+func TestPeekable(t *testing.T) {
+	t.Run("Peek", func(t *testing.T) {
+		p := NewPeekable(sliceIter(1, 2))
+		require.Equal(t, 1, *p.Peek())
+		require.Equal(t, 1, *p.Peek())
+		require.Equal(t, 2, SizeHint[int](p))
+		require.True(t, p.Next())
+		require.Equal(t, 1, p.Value())
+		require.Equal(t, 2, *p.Peek())
+		require.True(t, p.Next())
+		require.Nil(t, p.Peek())
+		require.False(t, p.Next())
+		require.Equal(t, 2, p.Value())
+	})
+
+	t.Run("NextIf", func(t *testing.T) {
+		p := NewPeekable(sliceIter(2, 4, 5, 6))
+		var evens []int
+		for p.NextIf(isEven) {
+			evens = append(evens, p.Value())
+		}
+		require.Equal(t, []int{2, 4}, evens)
+		require.Equal(t, vec.New(5, 6), Collect[int](p))
+		require.False(t, p.NextIf(isEven))
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		p := NewPeekable(sliceIter(1, 2, 3, 4))
+		require.True(t, p.Next())
+		p.Checkpoint()
+		require.True(t, p.Next())
+		require.True(t, p.Next())
+		require.Equal(t, 4, *p.Peek())
+		p.Restore()
+		require.Equal(t, 1, p.Value())
+		require.Equal(t, vec.New(2, 3, 4), Collect[int](p))
+		require.Panics(t, func() { p.Restore() })
+	})
+
+	t.Run("Release", func(t *testing.T) {
+		p := NewPeekable(sliceIter(1, 2, 3))
+		p.Checkpoint()
+		require.True(t, p.Next())
+		p.Release()
+		require.Nil(t, p.history)
+		require.Equal(t, vec.New(2, 3), Collect[int](p))
+		require.Panics(t, func() { p.Release() })
+	})
+
+	t.Run("nested checkpoints", func(t *testing.T) {
+		p := NewPeekable(sliceIter(1, 2, 3, 4, 5))
+		p.Checkpoint()
+		p.Next()
+		p.Checkpoint()
+		p.Next()
+		p.Next()
+		p.Restore()
+		require.Equal(t, 1, p.Value())
+		p.Checkpoint()
+		p.Next()
+		p.Release()
+		require.Equal(t, 2, p.Value())
+		p.Restore()
+		require.Nil(t, p.history)
+		require.Equal(t, vec.New(1, 2, 3, 4, 5), Collect[int](p))
+	})
+
+	t.Run("backtracking parser", func(t *testing.T) {
+		// Try to read a run of three evens, backtracking on failure
+		p := NewPeekable(sliceIter(2, 4, 1, 6, 8, 10, 3))
+		var runs, skipped []int
+		for p.Peek() != nil {
+			p.Checkpoint()
+			n := 0
+			for n < 3 && p.NextIf(isEven) {
+				n++
+			}
+			if n == 3 {
+				p.Release()
+				runs = append(runs, p.Value())
+				continue
+			}
+			p.Restore()
+			p.Next()
+			skipped = append(skipped, p.Value())
+		}
+		require.Equal(t, []int{10}, runs)
+		require.Equal(t, []int{2, 4, 1, 3}, skipped)
+	})
+}
@@ -0,0 +1,78 @@
+package iterator
+
+import (
+	"iter"
+)
+
+// Seq returns a sequence that yields the values of the iterator, for use in
+// a range loop. Ranging over the sequence consumes the iterator.
+func Seq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.Next() {
+			if !yield(it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns a sequence that yields the pairs of the iterator as key-value
+// pairs, for use in a range loop. Ranging over the sequence consumes the
+// iterator.
+func Seq2[K, V any](it Iterator[Pair[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for it.Next() {
+			pair := it.Value()
+			if !yield(pair.First, pair.Second) {
+				return
+			}
+		}
+	}
+}
+
+type pullIter[T any] struct {
+	next func() (T, bool)
+	stop func()
+	val  T
+	done bool
+}
+
+// FromSeq returns an iterator that yields the values of the sequence, along
+// with a function that stops the sequence. Like iter.Pull, the caller must
+// call stop if the iterator is not consumed to the end. Calling stop more
+// than once is safe.
+func FromSeq[T any](seq iter.Seq[T]) (Iterator[T], func()) {
+	next, stop := iter.Pull(seq)
+	p := &pullIter[T]{next: next, stop: stop}
+	return p, stop
+}
+
+func (p *pullIter[T]) Next() bool {
+	if p.done {
+		return false
+	}
+	val, ok := p.next()
+	if !ok {
+		p.done = true
+		p.stop()
+		return false
+	}
+	p.val = val
+	return true
+}
+
+func (p *pullIter[T]) Value() T {
+	return p.val
+}
+
+// FromSeq2 is like FromSeq, but yields the key-value pairs of the sequence
+// as Pairs.
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) (Iterator[Pair[K, V]], func()) {
+	return FromSeq(func(yield func(Pair[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(Pair[K, V]{First: k, Second: v}) {
+				return
+			}
+		}
+	})
+}
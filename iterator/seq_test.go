@@ -0,0 +1,68 @@
+package iterator
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/camdencheek/datastructures/vec"
+)
+
+func TestSeq(t *testing.T) {
+	t.Run("Seq", func(t *testing.T) {
+		require.Equal(t, []int{1, 2, 3}, slices.Collect(Seq(sliceIter(1, 2, 3))))
+
+		var res []int
+		for val := range Seq(sliceIter(1, 2, 3)) {
+			if val == 2 {
+				break
+			}
+			res = append(res, val)
+		}
+		require.Equal(t, []int{1}, res)
+	})
+
+	t.Run("Seq2", func(t *testing.T) {
+		res := maps.Collect(Seq2(Enumerate(FromSlice([]string{"a", "b"}))))
+		require.Equal(t, map[int]string{0: "a", 1: "b"}, res)
+	})
+
+	t.Run("FromSeq", func(t *testing.T) {
+		it, stop := FromSeq(slices.Values([]int{1, 2, 3}))
+		defer stop()
+		require.Equal(t, vec.New(2), Collect(Filter(it, isEven)))
+		require.False(t, it.Next())
+	})
+
+	t.Run("FromSeq stopped early", func(t *testing.T) {
+		finished := false
+		seq := func(yield func(int) bool) {
+			for i := 0; ; i++ {
+				if !yield(i) {
+					finished = true
+					return
+				}
+			}
+		}
+		it, stop := FromSeq(seq)
+		require.Equal(t, vec.New(0, 1, 2), Collect(Take(it, 3)))
+		stop()
+		stop()
+		require.True(t, finished)
+	})
+
+	t.Run("FromSeq2", func(t *testing.T) {
+		it, stop := FromSeq2(slices.All([]string{"a", "b"}))
+		defer stop()
+		res := Collect(it)
+		require.Equal(t, vec.New(Pair[int, string]{0, "a"}, Pair[int, string]{1, "b"}), res)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		it, stop := FromSeq(Seq(sliceIter(1, 2, 3)))
+		defer stop()
+		require.Equal(t, vec.New(1, 2, 3), Collect(it))
+	})
+}
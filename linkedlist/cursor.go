@@ -6,9 +6,34 @@ type Cursor[T any] struct {
 	current *node[T]
 	// index is only valid when current != nil
 	index int
+	// mods is the value of ll.mods when the cursor was last known to be
+	// valid
+	mods uint64
+}
+
+// Valid reports whether the cursor can still be used. A cursor becomes
+// invalid when its list is structurally changed other than through the
+// cursor itself.
+func (c *Cursor[T]) Valid() bool {
+	return c.mods == c.ll.mods
+}
+
+// check panics if the cursor is no longer valid.
+func (c *Cursor[T]) check() {
+	if c.mods != c.ll.mods {
+		panic("linkedlist: cursor used after the list was modified")
+	}
+}
+
+// sync records that the list was changed through this cursor, so the
+// cursor remains valid.
+func (c *Cursor[T]) sync() {
+	c.ll.mods++
+	c.mods = c.ll.mods
 }
 
 func (c *Cursor[T]) Current() *T {
+	c.check()
 	if c.current == nil {
 		return nil
 	}
@@ -16,12 +41,14 @@ func (c *Cursor[T]) Current() *T {
 }
 
 func (c *Cursor[T]) SetCurrent(val T) {
+	c.check()
 	if c.current != nil {
 		c.current.val = val
 	}
 }
 
 func (c *Cursor[T]) Index() *int {
+	c.check()
 	if c.current == nil {
 		return nil
 	}
@@ -29,6 +56,7 @@ func (c *Cursor[T]) Index() *int {
 }
 
 func (c *Cursor[T]) Next() bool {
+	c.check()
 	if c.current != nil {
 		c.current = c.current.next
 		c.index++
@@ -40,6 +68,7 @@ func (c *Cursor[T]) Next() bool {
 }
 
 func (c *Cursor[T]) Prev() bool {
+	c.check()
 	if c.current != nil {
 		c.current = c.current.prev
 		c.index--
@@ -51,24 +80,22 @@ func (c *Cursor[T]) Prev() bool {
 }
 
 func (c *Cursor[T]) InsertBefore(item T) {
+	c.check()
 	if c.current == nil {
 		c.ll.Push(item)
+		c.sync()
 		return
 	}
-	n := newNode(item, c.current.prev, c.current)
-	if c.current.prev == nil {
-		c.ll.head = n
-	} else {
-		c.current.prev.next = n
-	}
-	c.current.prev = n
+	c.ll.insertBefore(c.current, item)
 	c.index++
-	c.ll.length++
+	c.sync()
 }
 
 func (c *Cursor[T]) InsertAfter(item T) {
+	c.check()
 	if c.current == nil {
 		c.ll.PushHead(item)
+		c.sync()
 		return
 	}
 	n := newNode(item, c.current, c.current.next)
@@ -79,28 +106,140 @@ func (c *Cursor[T]) InsertAfter(item T) {
 	}
 	c.current.next = n
 	c.ll.length++
+	c.sync()
 }
 
 func (c *Cursor[T]) RemoveCurrent() *T {
+	c.check()
 	if c.current == nil {
 		return nil
 	}
 	unlinked := c.current
 	c.current = unlinked.next
+	c.ll.unlink(unlinked)
+	c.sync()
 
-	if unlinked.prev != nil {
-		unlinked.prev.next = unlinked.next
-	} else {
-		c.ll.head = unlinked.next
+	return &unlinked.val
+}
+
+// Seek moves the cursor to index i, walking from whichever of the head, the
+// tail or the cursor's current position is closest. Seek panics if i is out
+// of range.
+func (c *Cursor[T]) Seek(i int) {
+	c.check()
+	if c.current != nil && i >= 0 && i < c.ll.length {
+		if d := i - c.index; abs(d) < min(i, c.ll.length-1-i) {
+			for ; d > 0; d-- {
+				c.current = c.current.next
+			}
+			for ; d < 0; d++ {
+				c.current = c.current.prev
+			}
+			c.index = i
+			return
+		}
 	}
+	c.current = c.ll.nodeAt(i)
+	c.index = i
+}
 
-	if unlinked.next != nil {
-		unlinked.next.prev = unlinked.prev
-	} else {
-		c.ll.tail = unlinked.prev
+func abs(i int) int {
+	if i < 0 {
+		return -i
 	}
+	return i
+}
 
-	c.ll.length--
+// SplitAfter moves every node after the cursor into a new list and returns
+// it. If the cursor points to the ghost node, the entire list is moved. No
+// values are copied, and it runs in constant time.
+func (c *Cursor[T]) SplitAfter() LinkedList[T] {
+	c.check()
+	if c.current == nil {
+		split := c.ll.take()
+		c.sync()
+		return split
+	}
+	split := LinkedList[T]{
+		head:   c.current.next,
+		tail:   c.ll.tail,
+		length: c.ll.length - c.index - 1,
+	}
+	if split.head == nil {
+		return LinkedList[T]{}
+	}
+	split.head.prev = nil
+	c.current.next = nil
+	c.ll.tail = c.current
+	c.ll.length = c.index + 1
+	c.sync()
+	return split
+}
 
-	return &unlinked.val
+// SplitBefore moves every node before the cursor into a new list and returns
+// it. If the cursor points to the ghost node, the entire list is moved. No
+// values are copied, and it runs in constant time.
+func (c *Cursor[T]) SplitBefore() LinkedList[T] {
+	c.check()
+	if c.current == nil {
+		split := c.ll.take()
+		c.sync()
+		return split
+	}
+	split := LinkedList[T]{
+		head:   c.ll.head,
+		tail:   c.current.prev,
+		length: c.index,
+	}
+	if split.tail == nil {
+		return LinkedList[T]{}
+	}
+	split.tail.next = nil
+	c.current.prev = nil
+	c.ll.head = c.current
+	c.ll.length -= c.index
+	c.index = 0
+	c.sync()
+	return split
+}
+
+// SpliceAfter moves every node of other into the list after the cursor,
+// leaving other empty. If the cursor points to the ghost node, the nodes are
+// moved to the front of the list. No values are copied, and it runs in
+// constant time. It panics if other is the cursor's own list.
+func (c *Cursor[T]) SpliceAfter(other *LinkedList[T]) {
+	c.check()
+	moved := c.ll.takeOther(other)
+	if moved.length == 0 {
+		return
+	}
+	var prev, next *node[T]
+	if c.current == nil {
+		next = c.ll.head
+	} else {
+		prev, next = c.current, c.current.next
+	}
+	c.ll.link(prev, next, moved)
+	c.sync()
+}
+
+// SpliceBefore moves every node of other into the list before the cursor,
+// leaving other empty. If the cursor points to the ghost node, the nodes are
+// moved to the back of the list. No values are copied, and it runs in
+// constant time. It panics if other is the cursor's own list.
+func (c *Cursor[T]) SpliceBefore(other *LinkedList[T]) {
+	c.check()
+	moved := c.ll.takeOther(other)
+	if moved.length == 0 {
+		return
+	}
+	var prev, next *node[T]
+	if c.current == nil {
+		prev = c.ll.tail
+	} else {
+		prev, next = c.current.prev, c.current
+		c.index += moved.length
+	}
+	c.ll.link(prev, next, moved)
+	c.sync()
 }
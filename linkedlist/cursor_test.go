@@ -252,4 +252,206 @@ func TestCursor(t *testing.T) {
 			require.NoError(t, quick.Check(f, nil))
 		})
 	})
+
+	t.Run("CursorTail index", func(t *testing.T) {
+		ll := NewLinkedList(1, 2, 3)
+		require.Equal(t, 2, *ll.CursorTail().Index())
+	})
+
+	t.Run("SplitAfter", func(t *testing.T) {
+		for _, tc := range []struct {
+			moves       int
+			left, right []int
+		}{
+			{0, nil, []int{1, 2, 3}},
+			{1, []int{1}, []int{2, 3}},
+			{2, []int{1, 2}, []int{3}},
+			{3, []int{1, 2, 3}, nil},
+		} {
+			ll := NewLinkedList(1, 2, 3)
+			cursor := ll.CursorGhost()
+			for i := 0; i < tc.moves; i++ {
+				cursor.Next()
+			}
+			split := cursor.SplitAfter()
+			require.True(t, equivalent(t, ll, append([]int{}, tc.left...)), "moves %d", tc.moves)
+			require.True(t, equivalent(t, split, append([]int{}, tc.right...)), "moves %d", tc.moves)
+		}
+		t.Run("ghost moves everything", func(t *testing.T) {
+			ll := NewLinkedList(1, 2, 3)
+			split := ll.CursorGhost().SplitAfter()
+			require.True(t, equivalent(t, ll, []int{}))
+			require.True(t, equivalent(t, split, []int{1, 2, 3}))
+		})
+	})
+
+	t.Run("SplitBefore", func(t *testing.T) {
+		for _, tc := range []struct {
+			moves       int
+			left, right []int
+		}{
+			{1, nil, []int{1, 2, 3}},
+			{2, []int{1}, []int{2, 3}},
+			{3, []int{1, 2}, []int{3}},
+		} {
+			ll := NewLinkedList(1, 2, 3)
+			cursor := ll.CursorGhost()
+			for i := 0; i < tc.moves; i++ {
+				cursor.Next()
+			}
+			current := cursor.Current()
+			split := cursor.SplitBefore()
+			require.Equal(t, 0, *cursor.Index())
+			require.Same(t, current, cursor.Current())
+			require.True(t, equivalent(t, split, append([]int{}, tc.left...)), "moves %d", tc.moves)
+			require.True(t, equivalent(t, ll, append([]int{}, tc.right...)), "moves %d", tc.moves)
+		}
+		t.Run("ghost moves everything", func(t *testing.T) {
+			ll := NewLinkedList(1, 2, 3)
+			split := ll.CursorGhost().SplitBefore()
+			require.True(t, equivalent(t, ll, []int{}))
+			require.True(t, equivalent(t, split, []int{1, 2, 3}))
+		})
+	})
+
+	t.Run("SpliceAfter", func(t *testing.T) {
+		ll := NewLinkedList(1, 4)
+		other := NewLinkedList(2, 3)
+		moved := other.CursorHead().Current()
+		cursor := ll.CursorHead()
+		cursor.SpliceAfter(&other)
+		require.True(t, equivalent(t, ll, []int{1, 2, 3, 4}))
+		require.True(t, equivalent(t, other, []int{}))
+		require.Equal(t, 1, *cursor.Current())
+		require.Equal(t, 0, *cursor.Index())
+		cursor.Next()
+		require.Same(t, moved, cursor.Current())
+
+		other = NewLinkedList(-1, 0)
+		ll.CursorGhost().SpliceAfter(&other)
+		require.True(t, equivalent(t, ll, []int{-1, 0, 1, 2, 3, 4}))
+
+		cursor = ll.CursorTail()
+		cursor.SpliceAfter(&other)
+		require.True(t, equivalent(t, ll, []int{-1, 0, 1, 2, 3, 4}))
+		other = NewLinkedList(5)
+		cursor.SpliceAfter(&other)
+		require.True(t, equivalent(t, ll, []int{-1, 0, 1, 2, 3, 4, 5}))
+	})
+
+	t.Run("SpliceBefore", func(t *testing.T) {
+		ll := NewLinkedList(1, 4)
+		other := NewLinkedList(2, 3)
+		cursor := ll.CursorTail()
+		cursor.SpliceBefore(&other)
+		require.True(t, equivalent(t, ll, []int{1, 2, 3, 4}))
+		require.True(t, equivalent(t, other, []int{}))
+		require.Equal(t, 4, *cursor.Current())
+		require.Equal(t, 3, *cursor.Index())
+
+		other = NewLinkedList(5, 6)
+		ll.CursorGhost().SpliceBefore(&other)
+		require.True(t, equivalent(t, ll, []int{1, 2, 3, 4, 5, 6}))
+
+		empty := NewLinkedList[int]()
+		other = NewLinkedList(0)
+		empty.CursorHead().SpliceBefore(&other)
+		require.True(t, equivalent(t, empty, []int{0}))
+	})
+
+	t.Run("moving a list into itself", func(t *testing.T) {
+		ll := NewLinkedList(1, 2, 3)
+		const msg = "linkedlist: cannot move a list into itself"
+		require.PanicsWithValue(t, msg, func() { ll.CursorHead().SpliceAfter(&ll) })
+		require.PanicsWithValue(t, msg, func() { ll.CursorGhost().SpliceBefore(&ll) })
+		require.PanicsWithValue(t, msg, func() { ll.Append(&ll) })
+		require.True(t, equivalent(t, ll, []int{1, 2, 3}))
+	})
+
+	t.Run("quick check split and splice round trip", func(t *testing.T) {
+		f := func(a, b []int, at uint8) bool {
+			ll := NewLinkedList(a...)
+			other := NewLinkedList(b...)
+			// Point the cursor at index k, or at the ghost when k == len(a)
+			k := int(at) % (len(a) + 1)
+			cursor := ll.CursorGhost()
+			for i := 0; i <= k; i++ {
+				cursor.Next()
+			}
+			cursor.SpliceBefore(&other)
+			expected := append(append(append([]int{}, a[:k]...), b...), a[k:]...)
+			if !equivalent(t, ll, expected) {
+				return false
+			}
+			rest := cursor.SplitBefore()
+			rest.Append(&ll)
+			return equivalent(t, rest, expected) && equivalent(t, ll, []int{})
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+
+	t.Run("invalidation", func(t *testing.T) {
+		t.Run("own edits keep the cursor valid", func(t *testing.T) {
+			ll := NewLinkedList(1, 2, 3)
+			cursor := ll.CursorHead()
+			cursor.InsertAfter(4)
+			cursor.InsertBefore(0)
+			cursor.Next()
+			cursor.RemoveCurrent()
+			other := NewLinkedList(5)
+			cursor.SpliceBefore(&other)
+			require.True(t, cursor.Valid())
+			require.Equal(t, 2, *cursor.Current())
+			require.Equal(t, 3, *cursor.Index())
+			require.True(t, equivalent(t, ll, []int{0, 1, 5, 2, 3}))
+		})
+
+		t.Run("edits through another cursor", func(t *testing.T) {
+			ll := NewLinkedList(1, 2, 3)
+			a, b := ll.CursorHead(), ll.CursorTail()
+			b.RemoveCurrent()
+			require.False(t, a.Valid())
+			require.True(t, b.Valid())
+			require.Panics(t, func() { a.Next() })
+			require.Panics(t, func() { a.Current() })
+			require.Panics(t, func() { a.InsertAfter(4) })
+			require.True(t, equivalent(t, ll, []int{1, 2}))
+		})
+
+		t.Run("edits through the list", func(t *testing.T) {
+			edits := map[string]func(*LinkedList[int]){
+				"Push":     func(ll *LinkedList[int]) { ll.Push(4) },
+				"Pop":      func(ll *LinkedList[int]) { ll.Pop() },
+				"PushHead": func(ll *LinkedList[int]) { ll.PushHead(0) },
+				"PopHead":  func(ll *LinkedList[int]) { ll.PopHead() },
+				"Reverse":  func(ll *LinkedList[int]) { ll.Reverse() },
+				"Append": func(ll *LinkedList[int]) {
+					other := NewLinkedList(4)
+					ll.Append(&other)
+				},
+			}
+			for name, edit := range edits {
+				ll := NewLinkedList(1, 2, 3)
+				cursor := ll.CursorTail()
+				edit(&ll)
+				require.False(t, cursor.Valid(), name)
+				require.Panics(t, func() { cursor.Prev() }, name)
+			}
+		})
+
+		t.Run("spliced list", func(t *testing.T) {
+			ll, other := NewLinkedList(1), NewLinkedList(2)
+			cursor := other.CursorHead()
+			ll.CursorHead().SpliceAfter(&other)
+			require.False(t, cursor.Valid())
+		})
+
+		t.Run("iterator", func(t *testing.T) {
+			ll := NewLinkedList(1, 2, 3)
+			iter := ll.Iter()
+			require.True(t, iter.Next())
+			ll.PopHead()
+			require.Panics(t, func() { iter.Next() })
+		})
+	})
 }
@@ -0,0 +1,296 @@
+package linkedlist
+
+import (
+	"iter"
+)
+
+// Link holds the pointers that place a value in an IntrusiveList. Embed one
+// Link field in a struct for every list the struct can be a member of at the
+// same time:
+//
+//	type conn struct {
+//		id   int
+//		idle linkedlist.Link[conn]
+//		all  linkedlist.Link[conn]
+//	}
+//
+// The zero value is a Link that is not in any list.
+type Link[T any] struct {
+	prev *T
+	next *T
+	// list is the list the link is in, or nil
+	list *IntrusiveList[T]
+}
+
+// IntrusiveList is a doubly linked list whose nodes are the values
+// themselves. Instead of allocating a node per value, the list stores its
+// pointers in a Link field of each value, so a value can be in several lists
+// at once and can be removed from any of them in constant time.
+type IntrusiveList[T any] struct {
+	head   *T
+	tail   *T
+	length int
+	link   func(*T) *Link[T]
+	// mods counts the structural changes made to the list, so cursors can
+	// detect that the list changed out from under them
+	mods uint64
+}
+
+// NewIntrusiveList returns an empty list that links values through the Link
+// returned by link. For example:
+//
+//	idle := linkedlist.NewIntrusiveList(func(c *conn) *linkedlist.Link[conn] {
+//		return &c.idle
+//	})
+func NewIntrusiveList[T any](link func(*T) *Link[T]) *IntrusiveList[T] {
+	return &IntrusiveList[T]{link: link}
+}
+
+// Len returns the length of the list.
+func (l *IntrusiveList[T]) Len() int {
+	return l.length
+}
+
+// Contains reports whether elem is in the list.
+func (l *IntrusiveList[T]) Contains(elem *T) bool {
+	return l.link(elem).list == l
+}
+
+// Push adds elem to the end of the list. Push panics if elem is already in a
+// list through the same Link.
+func (l *IntrusiveList[T]) Push(elem *T) {
+	l.insert(elem, l.tail, nil)
+}
+
+// Pop removes and returns the last value of the list. If the list is empty,
+// it returns nil.
+func (l *IntrusiveList[T]) Pop() *T {
+	popped := l.tail
+	if popped != nil {
+		l.unlink(popped)
+	}
+	return popped
+}
+
+// PushHead adds elem to the front of the list. PushHead panics if elem is
+// already in a list through the same Link.
+func (l *IntrusiveList[T]) PushHead(elem *T) {
+	l.insert(elem, nil, l.head)
+}
+
+// PopHead removes and returns the first value of the list. If the list is
+// empty, it returns nil.
+func (l *IntrusiveList[T]) PopHead() *T {
+	popped := l.head
+	if popped != nil {
+		l.unlink(popped)
+	}
+	return popped
+}
+
+// Remove removes elem from the list in constant time and reports whether it
+// was in the list.
+func (l *IntrusiveList[T]) Remove(elem *T) bool {
+	if !l.Contains(elem) {
+		return false
+	}
+	l.unlink(elem)
+	return true
+}
+
+// All returns a sequence that yields the values of the list from head to
+// tail.
+func (l *IntrusiveList[T]) All() iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		for elem := l.head; elem != nil; elem = l.link(elem).next {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence that yields the values of the list from tail
+// to head.
+func (l *IntrusiveList[T]) Backward() iter.Seq[*T] {
+	return func(yield func(*T) bool) {
+		for elem := l.tail; elem != nil; elem = l.link(elem).prev {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// insert links elem between prev and next, which must be adjacent values in
+// the list. A nil prev or next stands for the ghost node.
+func (l *IntrusiveList[T]) insert(elem, prev, next *T) {
+	link := l.link(elem)
+	if link.list != nil {
+		panic("linkedlist: value is already in a list")
+	}
+	link.prev, link.next, link.list = prev, next, l
+	if prev == nil {
+		l.head = elem
+	} else {
+		l.link(prev).next = elem
+	}
+	if next == nil {
+		l.tail = elem
+	} else {
+		l.link(next).prev = elem
+	}
+	l.length++
+	l.mods++
+}
+
+// unlink removes elem from the list, clearing its Link.
+func (l *IntrusiveList[T]) unlink(elem *T) {
+	link := l.link(elem)
+	if link.prev == nil {
+		l.head = link.next
+	} else {
+		l.link(link.prev).next = link.next
+	}
+	if link.next == nil {
+		l.tail = link.prev
+	} else {
+		l.link(link.next).prev = link.prev
+	}
+	*link = Link[T]{}
+	l.length--
+	l.mods++
+}
+
+// CursorHead returns a cursor pointing to the first value in the list. If
+// the list is empty, the cursor will point to the "ghost" node. Cursors are
+// invalidated the same way as those of a LinkedList.
+func (l *IntrusiveList[T]) CursorHead() *IntrusiveCursor[T] {
+	return &IntrusiveCursor[T]{l: l, current: l.head, mods: l.mods}
+}
+
+// CursorTail returns a cursor pointing to the last value in the list. If the
+// list is empty, the cursor will point to the "ghost" node.
+func (l *IntrusiveList[T]) CursorTail() *IntrusiveCursor[T] {
+	return &IntrusiveCursor[T]{l: l, current: l.tail, index: l.length - 1, mods: l.mods}
+}
+
+// CursorGhost returns a cursor pointing to the "ghost" node, which logically
+// lies before head and after tail.
+func (l *IntrusiveList[T]) CursorGhost() *IntrusiveCursor[T] {
+	return &IntrusiveCursor[T]{l: l, mods: l.mods}
+}
+
+// IntrusiveCursor points to a value in an IntrusiveList, or to the "ghost"
+// node that logically lies before head and after tail.
+type IntrusiveCursor[T any] struct {
+	l *IntrusiveList[T]
+	// current is nil when pointing to the ghost node
+	current *T
+	// index is only valid when current != nil
+	index int
+	// mods is the value of l.mods when the cursor was last known to be
+	// valid
+	mods uint64
+}
+
+// Valid reports whether the cursor can still be used.
+func (c *IntrusiveCursor[T]) Valid() bool {
+	return c.mods == c.l.mods
+}
+
+// check panics if the cursor is no longer valid.
+func (c *IntrusiveCursor[T]) check() {
+	if c.mods != c.l.mods {
+		panic("linkedlist: cursor used after the list was modified")
+	}
+}
+
+// sync records that the list was changed through this cursor, so the
+// cursor remains valid.
+func (c *IntrusiveCursor[T]) sync() {
+	c.mods = c.l.mods
+}
+
+// Current returns the value the cursor points to, or nil at the ghost node.
+func (c *IntrusiveCursor[T]) Current() *T {
+	c.check()
+	return c.current
+}
+
+// Index returns the index of the value the cursor points to, or nil at the
+// ghost node.
+func (c *IntrusiveCursor[T]) Index() *int {
+	c.check()
+	if c.current == nil {
+		return nil
+	}
+	return &c.index
+}
+
+// Next moves the cursor towards the tail, wrapping from the ghost node to
+// the head, and reports whether it points to a value.
+func (c *IntrusiveCursor[T]) Next() bool {
+	c.check()
+	if c.current != nil {
+		c.current = c.l.link(c.current).next
+		c.index++
+	} else {
+		c.current = c.l.head
+		c.index = 0
+	}
+	return c.current != nil
+}
+
+// Prev moves the cursor towards the head, wrapping from the ghost node to
+// the tail, and reports whether it points to a value.
+func (c *IntrusiveCursor[T]) Prev() bool {
+	c.check()
+	if c.current != nil {
+		c.current = c.l.link(c.current).prev
+		c.index--
+	} else {
+		c.current = c.l.tail
+		c.index = c.l.length - 1
+	}
+	return c.current != nil
+}
+
+// InsertBefore links elem before the cursor. At the ghost node, elem is
+// added to the end of the list.
+func (c *IntrusiveCursor[T]) InsertBefore(elem *T) {
+	c.check()
+	if c.current == nil {
+		c.l.insert(elem, c.l.tail, nil)
+	} else {
+		c.l.insert(elem, c.l.link(c.current).prev, c.current)
+		c.index++
+	}
+	c.sync()
+}
+
+// InsertAfter links elem after the cursor. At the ghost node, elem is added
+// to the front of the list.
+func (c *IntrusiveCursor[T]) InsertAfter(elem *T) {
+	c.check()
+	if c.current == nil {
+		c.l.insert(elem, nil, c.l.head)
+	} else {
+		c.l.insert(elem, c.current, c.l.link(c.current).next)
+	}
+	c.sync()
+}
+
+// RemoveCurrent unlinks and returns the value the cursor points to, moving
+// the cursor to the next value. At the ghost node, it returns nil.
+func (c *IntrusiveCursor[T]) RemoveCurrent() *T {
+	c.check()
+	if c.current == nil {
+		return nil
+	}
+	removed := c.current
+	c.current = c.l.link(removed).next
+	c.l.unlink(removed)
+	c.sync()
+	return removed
+}
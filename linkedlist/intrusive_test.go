@@ -0,0 +1,143 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+)
+
+type entry struct {
+	id        int
+	even, all Link[entry]
+}
+
+func newEntryLists() (even, all *IntrusiveList[entry]) {
+	even = NewIntrusiveList(func(e *entry) *Link[entry] { return &e.even })
+	all = NewIntrusiveList(func(e *entry) *Link[entry] { return &e.all })
+	return even, all
+}
+
+// ids returns the ids of the list's values, checking that the list is
+// linked the same way in both directions.
+func ids(t *testing.T, l *IntrusiveList[entry]) []int {
+	t.Helper()
+	res := []int{}
+	for e := range l.All() {
+		res = append(res, e.id)
+	}
+	var back []int
+	for e := range l.Backward() {
+		back = append(back, e.id)
+	}
+	slices.Reverse(back)
+	require.Equal(t, res, append([]int{}, back...))
+	require.Equal(t, len(res), l.Len())
+	return res
+}
+
+func TestIntrusiveList(t *testing.T) {
+	t.Run("Push Pop", func(t *testing.T) {
+		_, all := newEntryLists()
+		require.Nil(t, all.Pop())
+		require.Nil(t, all.PopHead())
+		entries := []entry{{id: 0}, {id: 1}, {id: 2}}
+		all.Push(&entries[1])
+		all.Push(&entries[2])
+		all.PushHead(&entries[0])
+		require.Equal(t, []int{0, 1, 2}, ids(t, all))
+		require.Same(t, &entries[2], all.Pop())
+		require.Same(t, &entries[0], all.PopHead())
+		require.Equal(t, []int{1}, ids(t, all))
+		require.False(t, all.Contains(&entries[0]))
+	})
+
+	t.Run("several lists", func(t *testing.T) {
+		even, all := newEntryLists()
+		entries := make([]entry, 6)
+		for i := range entries {
+			entries[i].id = i
+			all.Push(&entries[i])
+			if i%2 == 0 {
+				even.Push(&entries[i])
+			}
+		}
+		require.True(t, even.Remove(&entries[2]))
+		require.False(t, even.Remove(&entries[3]))
+		require.True(t, all.Remove(&entries[4]))
+		require.Equal(t, []int{0, 4}, ids(t, even))
+		require.Equal(t, []int{0, 1, 2, 3, 5}, ids(t, all))
+		require.Panics(t, func() { all.Push(&entries[0]) })
+
+		// A value can't be removed through a list it is not in
+		other, _ := newEntryLists()
+		require.False(t, other.Remove(&entries[0]))
+		require.Equal(t, []int{0, 4}, ids(t, even))
+	})
+
+	t.Run("removal does not allocate", func(t *testing.T) {
+		_, all := newEntryLists()
+		entries := make([]entry, 3)
+		allocs := testing.AllocsPerRun(10, func() {
+			for i := range entries {
+				all.Push(&entries[i])
+			}
+			all.Remove(&entries[1])
+			all.Remove(&entries[0])
+			all.Remove(&entries[2])
+		})
+		require.Zero(t, allocs)
+	})
+
+	t.Run("Cursor", func(t *testing.T) {
+		_, all := newEntryLists()
+		entries := []entry{{id: 0}, {id: 1}, {id: 2}, {id: 3}}
+		cursor := all.CursorGhost()
+		cursor.InsertBefore(&entries[1])
+		cursor.InsertAfter(&entries[0])
+		require.True(t, cursor.Prev())
+		require.Equal(t, 1, *cursor.Index())
+		cursor.InsertAfter(&entries[3])
+		cursor.Next()
+		cursor.InsertBefore(&entries[2])
+		require.Equal(t, []int{0, 1, 2, 3}, ids(t, all))
+		require.Equal(t, 3, cursor.Current().id)
+		require.Equal(t, 3, *cursor.Index())
+
+		cursor = all.CursorHead()
+		require.Same(t, &entries[0], cursor.RemoveCurrent())
+		require.Equal(t, 1, cursor.Current().id)
+		require.Equal(t, 2, *all.CursorTail().Index())
+		require.Equal(t, []int{1, 2, 3}, ids(t, all))
+
+		all.PopHead()
+		require.False(t, cursor.Valid())
+		require.Panics(t, func() { cursor.Next() })
+	})
+
+	t.Run("quick check matches slice", func(t *testing.T) {
+		f := func(ops []uint8) bool {
+			_, all := newEntryLists()
+			entries := make([]entry, len(ops))
+			var expected []int
+			for i, op := range ops {
+				entries[i].id = i
+				switch {
+				case op%4 == 0 && len(expected) > 0:
+					at := int(op) % len(expected)
+					all.Remove(&entries[expected[at]])
+					expected = slices.Delete(expected, at, at+1)
+				case op%2 == 0:
+					all.PushHead(&entries[i])
+					expected = slices.Insert(expected, 0, i)
+				default:
+					all.Push(&entries[i])
+					expected = append(expected, i)
+				}
+			}
+			return slices.Equal(expected, ids(t, all))
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+}
@@ -1,17 +1,55 @@
 package linkedlist
 
 type iterator[T any] struct {
-	cursor  *Cursor[T]
-	reverse bool
+	// front and back are positioned at the last node yielded from each end,
+	// starting at the ghost node
+	front *Cursor[T]
+	back  *Cursor[T]
+	// current is whichever of front and back moved last
+	current   *Cursor[T]
+	remaining int
+	reverse   bool
+}
+
+func newIterator[T any](ll *LinkedList[T], reverse bool) *iterator[T] {
+	return &iterator[T]{
+		front:     ll.CursorGhost(),
+		back:      ll.CursorGhost(),
+		remaining: ll.Len(),
+		reverse:   reverse,
+	}
 }
 
 func (i *iterator[T]) Next() bool {
 	if i.reverse {
-		return i.cursor.Prev()
+		return i.step(i.back, (*Cursor[T]).Prev)
+	}
+	return i.step(i.front, (*Cursor[T]).Next)
+}
+
+func (i *iterator[T]) NextBack() bool {
+	if i.reverse {
+		return i.step(i.front, (*Cursor[T]).Next)
+	}
+	return i.step(i.back, (*Cursor[T]).Prev)
+}
+
+// step moves the cursor in one direction, unless the front and back have
+// already met.
+func (i *iterator[T]) step(c *Cursor[T], move func(*Cursor[T]) bool) bool {
+	if i.remaining == 0 {
+		return false
 	}
-	return i.cursor.Next()
+	move(c)
+	i.current = c
+	i.remaining--
+	return true
 }
 
 func (i *iterator[T]) Value() T {
-	return *i.cursor.Current()
+	return *i.current.Current()
+}
+
+func (i *iterator[T]) Len() int {
+	return i.remaining
 }
@@ -1,6 +1,8 @@
 package linkedlist
 
 import (
+	"iter"
+
 	. "github.com/camdencheek/datastructures/iterator"
 )
 
@@ -8,6 +10,9 @@ type LinkedList[T any] struct {
 	head   *node[T]
 	tail   *node[T]
 	length int
+	// mods counts the structural changes made to the list, so cursors can
+	// detect that the list changed out from under them
+	mods uint64
 }
 
 type node[T any] struct {
@@ -67,6 +72,7 @@ func (ll *LinkedList[T]) Push(item T) {
 		ll.tail = n
 	}
 	ll.length++
+	ll.mods++
 }
 
 // Pop will remove and return the last node of the linked list.
@@ -79,8 +85,12 @@ func (ll *LinkedList[T]) Pop() *T {
 	ll.tail = popped.prev
 	if ll.tail == nil {
 		ll.head = nil
+	} else {
+		ll.tail.next = nil
 	}
+	popped.prev = nil
 	ll.length--
+	ll.mods++
 	return &popped.val
 }
 
@@ -95,6 +105,7 @@ func (ll *LinkedList[T]) PushHead(item T) {
 		ll.head = n
 	}
 	ll.length++
+	ll.mods++
 }
 
 // PopHead will remove the first node of the linked list and return it.
@@ -107,11 +118,142 @@ func (ll *LinkedList[T]) PopHead() *T {
 	ll.head = popped.next
 	if ll.head == nil {
 		ll.tail = nil
+	} else {
+		ll.head.prev = nil
 	}
+	popped.next = nil
 	ll.length--
+	ll.mods++
 	return &popped.val
 }
 
+// Get returns the value at index i. Get panics if i is out of range.
+func (ll *LinkedList[T]) Get(i int) T {
+	return ll.nodeAt(i).val
+}
+
+// Set replaces the value at index i. Set panics if i is out of range.
+func (ll *LinkedList[T]) Set(i int, item T) {
+	ll.nodeAt(i).val = item
+}
+
+// InsertAt inserts the value at index i, shifting the value at that index
+// and every value after it one place towards the tail. Inserting at Len()
+// appends the value. InsertAt panics if i is out of range.
+func (ll *LinkedList[T]) InsertAt(i int, item T) {
+	if i == ll.length {
+		ll.Push(item)
+		return
+	}
+	ll.insertBefore(ll.nodeAt(i), item)
+	ll.mods++
+}
+
+// RemoveAt removes and returns the value at index i. RemoveAt panics if i is
+// out of range.
+func (ll *LinkedList[T]) RemoveAt(i int) T {
+	n := ll.nodeAt(i)
+	ll.unlink(n)
+	ll.mods++
+	return n.val
+}
+
+// nodeAt returns the node at index i, walking from whichever end of the list
+// is closest. nodeAt panics if i is out of range.
+func (ll *LinkedList[T]) nodeAt(i int) *node[T] {
+	if i < 0 || i >= ll.length {
+		panic("linkedlist: index out of range")
+	}
+	if i < ll.length/2 {
+		n := ll.head
+		for ; i > 0; i-- {
+			n = n.next
+		}
+		return n
+	}
+	n := ll.tail
+	for i = ll.length - 1 - i; i > 0; i-- {
+		n = n.prev
+	}
+	return n
+}
+
+// insertBefore inserts a new node holding item before the node next.
+func (ll *LinkedList[T]) insertBefore(next *node[T], item T) {
+	n := newNode(item, next.prev, next)
+	if next.prev == nil {
+		ll.head = n
+	} else {
+		next.prev.next = n
+	}
+	next.prev = n
+	ll.length++
+}
+
+// unlink removes the node from the list, clearing its links.
+func (ll *LinkedList[T]) unlink(n *node[T]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		ll.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		ll.tail = n.prev
+	}
+	n.prev, n.next = nil, nil
+	ll.length--
+}
+
+// Append moves every node of other to the end of the linked list, leaving
+// other empty. No values are copied, and it runs in constant time. Append
+// panics if other is the list itself.
+func (ll *LinkedList[T]) Append(other *LinkedList[T]) {
+	moved := ll.takeOther(other)
+	if moved.length == 0 {
+		return
+	}
+	ll.link(ll.tail, nil, moved)
+}
+
+// take empties the linked list and returns its former contents.
+func (ll *LinkedList[T]) take() LinkedList[T] {
+	taken := LinkedList[T]{head: ll.head, tail: ll.tail, length: ll.length}
+	ll.head, ll.tail, ll.length = nil, nil, 0
+	ll.mods++
+	return taken
+}
+
+// takeOther is like other.take, but panics if other is ll, since moving a
+// list into itself would link its tail back to its head.
+func (ll *LinkedList[T]) takeOther(other *LinkedList[T]) LinkedList[T] {
+	if other == ll {
+		panic("linkedlist: cannot move a list into itself")
+	}
+	return other.take()
+}
+
+// link inserts the nodes of the non-empty list moved between prev and next,
+// which must be adjacent nodes of ll. A nil prev or next stands for the
+// ghost node.
+func (ll *LinkedList[T]) link(prev, next *node[T], moved LinkedList[T]) {
+	moved.head.prev = prev
+	if prev == nil {
+		ll.head = moved.head
+	} else {
+		prev.next = moved.head
+	}
+	moved.tail.next = next
+	if next == nil {
+		ll.tail = moved.tail
+	} else {
+		next.prev = moved.tail
+	}
+	ll.length += moved.length
+	ll.mods++
+}
+
 // Reverse reverses the linked list
 func (ll *LinkedList[T]) Reverse() {
 	current := ll.head
@@ -120,6 +262,7 @@ func (ll *LinkedList[T]) Reverse() {
 		current = current.prev
 	}
 	ll.head, ll.tail = ll.tail, ll.head
+	ll.mods++
 }
 
 // ToSlice collects the nodes of the linked list and returns them as a slice.
@@ -132,28 +275,69 @@ func (ll *LinkedList[T]) ToSlice() []T {
 	return res
 }
 
+// Iter returns an iterator over the values of the linked list from head to
+// tail. The iterator also implements DoubleEnded and ExactSize. It panics if
+// the list is modified while iterating.
 func (ll *LinkedList[T]) Iter() Iterator[T] {
-	return &iterator[T]{cursor: ll.CursorGhost()}
+	return newIterator(ll, false)
 }
 
+// IterReverse returns an iterator over the values of the linked list from
+// tail to head. The iterator also implements DoubleEnded and ExactSize. It
+// panics if the list is modified while iterating.
 func (ll *LinkedList[T]) IterReverse() Iterator[T] {
-	return &iterator[T]{cursor: ll.CursorGhost(), reverse: true}
+	return newIterator(ll, true)
+}
+
+// All returns a sequence that yields the values of the linked list from head
+// to tail.
+func (ll *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := ll.head; n != nil; n = n.next {
+			if !yield(n.val) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence that yields the values of the linked list from
+// tail to head.
+func (ll *LinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := ll.tail; n != nil; n = n.prev {
+			if !yield(n.val) {
+				return
+			}
+		}
+	}
 }
 
 // CursorHead returns a cursor pointing to the first node in the linked list.
 // If the list is empty, the cursor will point to the "ghost" node.
+//
+// A cursor is invalidated by any structural change to the list that is not
+// made through the cursor itself, including changes made through other
+// cursors. Using an invalidated cursor panics; Valid reports whether a
+// cursor can still be used.
 func (ll *LinkedList[T]) CursorHead() *Cursor[T] {
-	return &Cursor[T]{ll: ll, current: ll.head}
+	return &Cursor[T]{ll: ll, current: ll.head, mods: ll.mods}
 }
 
 // CursorTail returns a cursor pointing to the last node in the linked list.
 // If the list is empty, the cursor will point to the "ghost" node.
 func (ll *LinkedList[T]) CursorTail() *Cursor[T] {
-	return &Cursor[T]{ll: ll, current: ll.tail}
+	return &Cursor[T]{ll: ll, current: ll.tail, index: ll.length - 1, mods: ll.mods}
+}
+
+// CursorAt returns a cursor pointing to the node at index i, walking from
+// whichever end of the list is closest. CursorAt panics if i is out of range.
+func (ll *LinkedList[T]) CursorAt(i int) *Cursor[T] {
+	return &Cursor[T]{ll: ll, current: ll.nodeAt(i), index: i, mods: ll.mods}
 }
 
 // CursorGhost returns a cursor pointing to the "ghost" node, which
 // logically lies before head and after tail.
 func (ll *LinkedList[T]) CursorGhost() *Cursor[T] {
-	return &Cursor[T]{ll: ll, current: nil}
+	return &Cursor[T]{ll: ll, current: nil, mods: ll.mods}
 }
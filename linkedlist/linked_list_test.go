@@ -2,11 +2,15 @@ package linkedlist
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 	"testing/quick"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	. "github.com/camdencheek/datastructures/iterator"
+	"github.com/camdencheek/datastructures/vec"
 )
 
 func TestLinkedList(t *testing.T) {
@@ -95,6 +99,16 @@ func TestLinkedList(t *testing.T) {
 			require.Equal(t, 2, *ll.PopHead())
 			require.Nil(t, ll.PopHead())
 		})
+
+		t.Run("unlinks popped nodes", func(t *testing.T) {
+			ll := NewLinkedList(1, 2, 3)
+			ll.Pop()
+			ll.PopHead()
+			require.True(t, equivalent(t, ll, []int{2}))
+			ll.Push(4)
+			ll.PushHead(0)
+			require.True(t, equivalent(t, ll, []int{0, 2, 4}))
+		})
 	})
 
 	t.Run("PushHead", func(t *testing.T) {
@@ -206,6 +220,86 @@ func TestLinkedList(t *testing.T) {
 
 }
 
+func TestLinkedListIndexing(t *testing.T) {
+	t.Run("Get Set", func(t *testing.T) {
+		ll := NewLinkedList(1, 2, 3, 4, 5)
+		for i := 0; i < 5; i++ {
+			require.Equal(t, i+1, ll.Get(i))
+			ll.Set(i, -i)
+		}
+		require.True(t, equivalent(t, ll, []int{0, -1, -2, -3, -4}))
+		require.Panics(t, func() { ll.Get(5) })
+		require.Panics(t, func() { ll.Set(-1, 0) })
+	})
+
+	t.Run("InsertAt RemoveAt", func(t *testing.T) {
+		ll := NewLinkedList[int]()
+		ll.InsertAt(0, 2)
+		ll.InsertAt(0, 0)
+		ll.InsertAt(1, 1)
+		ll.InsertAt(3, 3)
+		require.True(t, equivalent(t, ll, []int{0, 1, 2, 3}))
+		require.Panics(t, func() { ll.InsertAt(5, 5) })
+		require.Equal(t, 1, ll.RemoveAt(1))
+		require.Equal(t, 3, ll.RemoveAt(2))
+		require.Equal(t, 0, ll.RemoveAt(0))
+		require.True(t, equivalent(t, ll, []int{2}))
+		require.Panics(t, func() { ll.RemoveAt(1) })
+	})
+
+	t.Run("quick check matches slice", func(t *testing.T) {
+		f := func(ops []uint16) bool {
+			var (
+				ll       LinkedList[int]
+				expected = []int{}
+			)
+			for i, op := range ops {
+				if op%3 != 0 || len(expected) == 0 {
+					at := int(op) % (len(expected) + 1)
+					ll.InsertAt(at, i)
+					expected = slices.Insert(expected, at, i)
+					continue
+				}
+				at := int(op) % len(expected)
+				if ll.RemoveAt(at) != expected[at] {
+					return false
+				}
+				expected = slices.Delete(expected, at, at+1)
+			}
+			return equivalent(t, ll, expected)
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+
+	t.Run("CursorAt", func(t *testing.T) {
+		ll := NewLinkedList(0, 1, 2, 3, 4, 5)
+		for i := 0; i < ll.Len(); i++ {
+			cursor := ll.CursorAt(i)
+			require.Equal(t, i, *cursor.Current())
+			require.Equal(t, i, *cursor.Index())
+		}
+		require.Panics(t, func() { ll.CursorAt(6) })
+		require.Panics(t, func() { ll.CursorAt(-1) })
+	})
+
+	t.Run("Seek", func(t *testing.T) {
+		ll := NewLinkedList(0, 1, 2, 3, 4, 5, 6, 7)
+		for from := -1; from < ll.Len(); from++ {
+			for to := 0; to < ll.Len(); to++ {
+				cursor := ll.CursorGhost()
+				if from >= 0 {
+					cursor = ll.CursorAt(from)
+				}
+				cursor.Seek(to)
+				require.Equal(t, to, *cursor.Current())
+				require.Equal(t, to, *cursor.Index())
+				require.True(t, cursor.Prev() == (to > 0))
+			}
+		}
+		require.Panics(t, func() { ll.CursorHead().Seek(8) })
+	})
+}
+
 // equivalent steps through each node in the given linked list and checks that it
 // is forward connected, backward connected, and each node is equivalent to the same index
 // in the slice.
@@ -242,3 +336,73 @@ func equivalent(t *testing.T, ll LinkedList[int], s []int) bool {
 	assert.Equal(t, s, ll.ToSlice())
 	return !t.Failed()
 }
+
+func TestLinkedListAdapters(t *testing.T) {
+	t.Run("iterators compose with adapters", func(t *testing.T) {
+		ll := NewLinkedList(1, 2, 3, 4, 5)
+		evens := Filter(ll.Iter(), func(i int) bool { return i%2 == 0 })
+		res := Collect(Take(Chain(ll.IterReverse(), evens), 7))
+		require.Equal(t, vec.New(5, 4, 3, 2, 1, 2, 4), res)
+	})
+
+	t.Run("All Backward", func(t *testing.T) {
+		ll := NewLinkedList(1, 2, 3)
+		require.Equal(t, []int{1, 2, 3}, slices.Collect(ll.All()))
+		require.Equal(t, []int{3, 2, 1}, slices.Collect(ll.Backward()))
+		for val := range ll.All() {
+			require.Equal(t, 1, val)
+			break
+		}
+	})
+
+	t.Run("double-ended iterators meet in the middle", func(t *testing.T) {
+		f := func(items []int, fromBack []bool) bool {
+			ll := NewLinkedList(items...)
+			it := ll.Iter().(DoubleEnded[int])
+			front, back := []int{}, []int{}
+			for i := 0; ; i++ {
+				if it.(ExactSize[int]).Len() != len(items)-len(front)-len(back) {
+					return false
+				}
+				if i < len(fromBack) && fromBack[i] {
+					if !it.NextBack() {
+						break
+					}
+					back = append([]int{it.Value()}, back...)
+				} else {
+					if !it.Next() {
+						break
+					}
+					front = append(front, it.Value())
+				}
+			}
+			return reflect.DeepEqual(append(front, back...), append([]int{}, items...))
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+
+	t.Run("reverse iterator NextBack", func(t *testing.T) {
+		ll := NewLinkedList(1, 2, 3)
+		it := Rev(ll.IterReverse().(DoubleEnded[int]))
+		require.Equal(t, vec.New(1, 2, 3), Collect[int](it))
+	})
+
+	t.Run("iterators do not wrap around", func(t *testing.T) {
+		ll := NewLinkedList(1, 2)
+		iter := ll.Iter()
+		require.Equal(t, 2, Count(iter))
+		require.False(t, iter.Next())
+		require.False(t, iter.Next())
+	})
+
+	t.Run("Peekable", func(t *testing.T) {
+		ll := NewLinkedList(1, 2, 3, 4)
+		p := NewPeekable(ll.Iter())
+		require.True(t, p.NextIf(func(i int) bool { return i == 1 }))
+		p.Checkpoint()
+		require.Equal(t, 2, *p.Peek())
+		require.Equal(t, 3, Count[int](p))
+		p.Restore()
+		require.Equal(t, vec.New(2, 3, 4), Collect[int](p))
+	})
+}
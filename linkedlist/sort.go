@@ -0,0 +1,99 @@
+package linkedlist
+
+import (
+	"github.com/camdencheek/datastructures/compare"
+)
+
+// Sort sorts the linked list in ascending order according to cmp. The sort
+// is stable, so equal values keep their relative order. It is a bottom-up
+// merge sort that relinks the existing nodes, so it does not allocate and
+// values are never copied.
+func (ll *LinkedList[T]) Sort(cmp compare.CompareFunc[T]) {
+	if ll.length < 2 {
+		return
+	}
+	ll.mods++
+	// Merge runs of width nodes, doubling width on each pass. Only the next
+	// pointers are maintained until the end.
+	for width := 1; width < ll.length; width *= 2 {
+		var head *node[T]
+		link := &head
+		rest := ll.head
+		for rest != nil {
+			a := rest
+			b := cutAfter(a, width)
+			rest = cutAfter(b, width)
+			var last *node[T]
+			*link, last = merge(a, b, cmp)
+			link = &last.next
+		}
+		ll.head = head
+	}
+	ll.relink()
+}
+
+// MergeSorted merges two linked lists that are already sorted according to
+// cmp into a single sorted list, leaving both a and b empty. When values are
+// equal, those from a come first. The nodes of a and b are relinked, so
+// values are never copied.
+func MergeSorted[T any](a, b *LinkedList[T], cmp compare.CompareFunc[T]) LinkedList[T] {
+	la, lb := a.take(), b.take()
+	if la.length == 0 {
+		return lb
+	}
+	if lb.length == 0 {
+		return la
+	}
+	merged := LinkedList[T]{length: la.length + lb.length}
+	merged.head, _ = merge(la.head, lb.head, cmp)
+	merged.relink()
+	return merged
+}
+
+// cutAfter detaches the chain of next pointers starting at n after k nodes
+// and returns the rest of the chain, or nil if there are k nodes or fewer.
+func cutAfter[T any](n *node[T], k int) *node[T] {
+	for ; n != nil && k > 1; k-- {
+		n = n.next
+	}
+	if n == nil {
+		return nil
+	}
+	rest := n.next
+	n.next = nil
+	return rest
+}
+
+// merge merges two sorted chains of next pointers, preferring a when values
+// are equal. It returns the first and last node of the merged chain.
+func merge[T any](a, b *node[T], cmp compare.CompareFunc[T]) (head, last *node[T]) {
+	link := &head
+	for a != nil && b != nil {
+		if cmp.Less(b.val, a.val) {
+			*link, b = b, b.next
+		} else {
+			*link, a = a, a.next
+		}
+		last = *link
+		link = &last.next
+	}
+	if a == nil {
+		a = b
+	}
+	*link = a
+	for ; a != nil; a = a.next {
+		last = a
+	}
+	return head, last
+}
+
+// relink restores the prev pointers and the tail of a list whose nodes are
+// only linked by their next pointers.
+func (ll *LinkedList[T]) relink() {
+	var prev *node[T]
+	for n := ll.head; n != nil; n = n.next {
+		n.prev = prev
+		prev = n
+	}
+	ll.tail = prev
+}
@@ -0,0 +1,107 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/camdencheek/datastructures/compare"
+)
+
+type keyed struct {
+	key, seq int
+}
+
+var byKey = compare.By(func(k keyed) int { return k.key })
+
+func TestSort(t *testing.T) {
+	ints := compare.Ordered[int]()
+
+	t.Run("empty", func(t *testing.T) {
+		ll := NewLinkedList[int]()
+		ll.Sort(ints)
+		require.True(t, equivalent(t, ll, []int{}))
+	})
+
+	t.Run("quick check matches slices.Sort", func(t *testing.T) {
+		f := func(s []int) bool {
+			ll := NewLinkedList(s...)
+			ll.Sort(ints)
+			slices.Sort(s)
+			return equivalent(t, ll, s)
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+
+	t.Run("stable", func(t *testing.T) {
+		f := func(keys []uint8) bool {
+			s := make([]keyed, len(keys))
+			for i, key := range keys {
+				s[i] = keyed{key: int(key % 8), seq: i}
+			}
+			ll := NewLinkedList(s...)
+			ll.Sort(byKey)
+			slices.SortStableFunc(s, func(a, b keyed) int { return a.key - b.key })
+			return slices.Equal(s, ll.ToSlice())
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+
+	t.Run("keeps nodes", func(t *testing.T) {
+		ll := NewLinkedList(3, 1, 2)
+		three := ll.CursorHead().Current()
+		ll.Sort(ints)
+		require.True(t, equivalent(t, ll, []int{1, 2, 3}))
+		require.Same(t, three, ll.CursorTail().Current())
+	})
+
+	t.Run("does not allocate", func(t *testing.T) {
+		ll := NewLinkedList(5, 3, 8, 1, 9, 2, 7)
+		allocs := testing.AllocsPerRun(10, func() {
+			ll.Reverse()
+			ll.Sort(ints)
+		})
+		require.Zero(t, allocs)
+	})
+}
+
+func TestMergeSorted(t *testing.T) {
+	t.Run("quick check", func(t *testing.T) {
+		f := func(a, b []uint8) bool {
+			var sa, sb []keyed
+			for i, key := range a {
+				sa = append(sa, keyed{key: int(key % 8), seq: i})
+			}
+			for i, key := range b {
+				sb = append(sb, keyed{key: int(key % 8), seq: len(a) + i})
+			}
+			slices.SortStableFunc(sa, func(a, b keyed) int { return a.key - b.key })
+			slices.SortStableFunc(sb, func(a, b keyed) int { return a.key - b.key })
+
+			la, lb := NewLinkedList(sa...), NewLinkedList(sb...)
+			merged := MergeSorted(&la, &lb, byKey)
+
+			expected := append(append([]keyed{}, sa...), sb...)
+			slices.SortStableFunc(expected, func(a, b keyed) int { return a.key - b.key })
+			return la.Len() == 0 && lb.Len() == 0 &&
+				merged.Len() == len(expected) &&
+				slices.Equal(expected, merged.ToSlice()) &&
+				slices.Equal(expected, reversed(slices.Collect(merged.Backward())))
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+
+	t.Run("one empty", func(t *testing.T) {
+		a, b := NewLinkedList(1, 2), NewLinkedList[int]()
+		merged := MergeSorted(&a, &b, compare.Ordered[int]())
+		require.True(t, equivalent(t, merged, []int{1, 2}))
+		require.True(t, equivalent(t, a, []int{}))
+	})
+}
+
+func reversed[T any](s []T) []T {
+	slices.Reverse(s)
+	return s
+}
@@ -0,0 +1,193 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrClosed is returned when adding to a closed queue, or when removing from
+// a closed queue that has been fully drained.
+var ErrClosed = errors.New("queue: closed")
+
+// Blocking is a multi-producer, multi-consumer queue that is safe for
+// concurrent use. Unlike a Go channel, a Blocking queue can be unbounded, in
+// which case enqueueing never blocks.
+type Blocking[T any] struct {
+	mu       sync.Mutex
+	notEmpty sync.Cond
+	notFull  sync.Cond
+	queue    Queue[T]
+	// capacity is the maximum number of values the queue can hold,
+	// or zero if the queue is unbounded.
+	capacity int
+	closed   bool
+}
+
+// NewBlocking creates a new blocking queue that holds at most capacity
+// values. If capacity is zero or negative, the queue is unbounded.
+func NewBlocking[T any](capacity int) *Blocking[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
+	b := &Blocking[T]{capacity: capacity}
+	b.notEmpty.L = &b.mu
+	b.notFull.L = &b.mu
+	return b
+}
+
+// Cap returns the maximum number of values the queue can hold,
+// or zero if the queue is unbounded.
+func (b *Blocking[T]) Cap() int {
+	return b.capacity
+}
+
+// Len returns the current number of values in the queue.
+func (b *Blocking[T]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.queue.Len()
+}
+
+// Close closes the queue. Values that are already in the queue can still be
+// dequeued, but any attempt to enqueue will fail with ErrClosed. Blocked
+// callers are woken. Closing an already-closed queue does nothing.
+func (b *Blocking[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	b.notEmpty.Broadcast()
+	b.notFull.Broadcast()
+}
+
+// Enqueue adds the value to the back of the queue, blocking while the queue
+// is full. It returns ErrClosed if the queue is closed.
+func (b *Blocking[T]) Enqueue(val T) error {
+	return b.EnqueueContext(context.Background(), val)
+}
+
+// EnqueueContext is like Enqueue, but returns ctx.Err() if the context is
+// done before there is room in the queue.
+func (b *Blocking[T]) EnqueueContext(ctx context.Context, val T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.wait(ctx, &b.notFull, b.canEnqueue); err != nil {
+		return err
+	}
+	if b.closed {
+		return ErrClosed
+	}
+	b.push(val)
+	return nil
+}
+
+// TryEnqueue adds the value to the back of the queue if there is room
+// without blocking. It returns false if the queue is full or closed.
+func (b *Blocking[T]) TryEnqueue(val T) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed || b.full() {
+		return false
+	}
+	b.push(val)
+	return true
+}
+
+// Dequeue removes and returns the value at the front of the queue, blocking
+// while the queue is empty. Once the queue is closed and drained, it returns
+// false.
+func (b *Blocking[T]) Dequeue() (T, bool) {
+	val, err := b.DequeueContext(context.Background())
+	return val, err == nil
+}
+
+// DequeueContext is like Dequeue, but returns ctx.Err() if the context is
+// done before a value is available, and ErrClosed if the queue is closed and
+// drained.
+func (b *Blocking[T]) DequeueContext(ctx context.Context) (res T, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.wait(ctx, &b.notEmpty, b.canDequeue); err != nil {
+		return res, err
+	}
+	if b.queue.Len() == 0 {
+		return res, ErrClosed
+	}
+	return b.pop(), nil
+}
+
+// TryDequeue removes and returns the value at the front of the queue if one
+// is available without blocking. It returns false if the queue is empty.
+func (b *Blocking[T]) TryDequeue() (res T, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.queue.Len() == 0 {
+		return res, false
+	}
+	return b.pop(), true
+}
+
+func (b *Blocking[T]) full() bool {
+	return b.capacity > 0 && b.queue.Len() >= b.capacity
+}
+
+func (b *Blocking[T]) canEnqueue() bool {
+	return b.closed || !b.full()
+}
+
+func (b *Blocking[T]) canDequeue() bool {
+	return b.closed || b.queue.Len() > 0
+}
+
+// push adds a value and wakes a waiting consumer. b.mu must be held.
+func (b *Blocking[T]) push(val T) {
+	b.queue.PushBack(val)
+	b.notEmpty.Signal()
+}
+
+// pop removes a value and wakes a waiting producer. b.mu must be held.
+func (b *Blocking[T]) pop() T {
+	val := *b.queue.PopFront()
+	b.notFull.Signal()
+	return val
+}
+
+// wait blocks on cond until ready returns true or ctx is done. b.mu must be
+// held.
+func (b *Blocking[T]) wait(ctx context.Context, cond *sync.Cond, ready func() bool) error {
+	if ready() {
+		return nil
+	}
+
+	if ctx.Done() == nil {
+		for !ready() {
+			cond.Wait()
+		}
+		return nil
+	}
+
+	// sync.Cond cannot select on a channel, so wake all waiters when the
+	// context is done and let each check its own context.
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			b.mu.Lock()
+			cond.Broadcast()
+			b.mu.Unlock()
+		case <-stop:
+		}
+	}()
+
+	for !ready() {
+		if err := ctx.Err(); err != nil {
+			// We may have consumed a signal meant for a waiter that can
+			// still make progress, so pass it on.
+			cond.Signal()
+			return err
+		}
+		cond.Wait()
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlocking(t *testing.T) {
+	t.Run("FIFO", func(t *testing.T) {
+		b := NewBlocking[int](0)
+		for i := 0; i < 100; i++ {
+			require.NoError(t, b.Enqueue(i))
+		}
+		require.Equal(t, 100, b.Len())
+		for i := 0; i < 100; i++ {
+			val, ok := b.Dequeue()
+			require.True(t, ok)
+			require.Equal(t, i, val)
+		}
+	})
+
+	t.Run("TryEnqueue respects capacity", func(t *testing.T) {
+		b := NewBlocking[int](2)
+		require.True(t, b.TryEnqueue(1))
+		require.True(t, b.TryEnqueue(2))
+		require.False(t, b.TryEnqueue(3))
+		val, ok := b.TryDequeue()
+		require.True(t, ok)
+		require.Equal(t, 1, val)
+		require.True(t, b.TryEnqueue(3))
+	})
+
+	t.Run("TryDequeue empty", func(t *testing.T) {
+		b := NewBlocking[int](0)
+		_, ok := b.TryDequeue()
+		require.False(t, ok)
+	})
+
+	t.Run("Close drains remaining values", func(t *testing.T) {
+		b := NewBlocking[int](0)
+		require.NoError(t, b.Enqueue(1))
+		require.NoError(t, b.Enqueue(2))
+		b.Close()
+		require.ErrorIs(t, b.Enqueue(3), ErrClosed)
+		require.False(t, b.TryEnqueue(3))
+
+		val, ok := b.Dequeue()
+		require.True(t, ok)
+		require.Equal(t, 1, val)
+		val, ok = b.Dequeue()
+		require.True(t, ok)
+		require.Equal(t, 2, val)
+		_, ok = b.Dequeue()
+		require.False(t, ok)
+		_, err := b.DequeueContext(context.Background())
+		require.ErrorIs(t, err, ErrClosed)
+	})
+
+	t.Run("Close wakes blocked callers", func(t *testing.T) {
+		b := NewBlocking[int](1)
+		require.NoError(t, b.Enqueue(1))
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.ErrorIs(t, b.Enqueue(2), ErrClosed)
+		}()
+
+		empty := NewBlocking[int](0)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, ok := empty.Dequeue()
+			assert.False(t, ok)
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		b.Close()
+		empty.Close()
+		wg.Wait()
+	})
+
+	t.Run("DequeueContext canceled", func(t *testing.T) {
+		b := NewBlocking[int](0)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := b.DequeueContext(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("EnqueueContext canceled", func(t *testing.T) {
+		b := NewBlocking[int](1)
+		require.NoError(t, b.Enqueue(1))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.ErrorIs(t, b.EnqueueContext(ctx, 2), context.Canceled)
+		require.Equal(t, 1, b.Len())
+	})
+
+	t.Run("canceled waiter does not swallow wakeups", func(t *testing.T) {
+		b := NewBlocking[int](0)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		// Either waiter may legitimately receive the value, but one of
+		// them must.
+		received := make(chan int, 2)
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if val, err := b.DequeueContext(ctx); err == nil {
+				received <- val
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if val, ok := b.Dequeue(); ok {
+				received <- val
+			}
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+		require.NoError(t, b.Enqueue(42))
+		select {
+		case val := <-received:
+			require.Equal(t, 42, val)
+		case <-time.After(5 * time.Second):
+			t.Fatal("value was never dequeued")
+		}
+		b.Close()
+		wg.Wait()
+	})
+
+	for _, capacity := range []int{0, 1, 16} {
+		capacity := capacity
+		t.Run(fmt.Sprintf("many producers and consumers capacity %d", capacity), func(t *testing.T) {
+			const (
+				producers   = 8
+				consumers   = 8
+				perProducer = 1000
+			)
+			b := NewBlocking[int](capacity)
+
+			var producerWG sync.WaitGroup
+			for p := 0; p < producers; p++ {
+				producerWG.Add(1)
+				go func(p int) {
+					defer producerWG.Done()
+					for i := 0; i < perProducer; i++ {
+						assert.NoError(t, b.Enqueue(p*perProducer+i))
+					}
+				}(p)
+			}
+
+			results := make(chan []int, consumers)
+			for c := 0; c < consumers; c++ {
+				go func() {
+					var got []int
+					for {
+						val, ok := b.Dequeue()
+						if !ok {
+							break
+						}
+						got = append(got, val)
+					}
+					results <- got
+				}()
+			}
+
+			producerWG.Wait()
+			b.Close()
+
+			var all []int
+			for c := 0; c < consumers; c++ {
+				all = append(all, <-results...)
+			}
+			sort.Ints(all)
+			require.Len(t, all, producers*perProducer)
+			for i, val := range all {
+				require.Equal(t, i, val)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package queue
+
+type iterator[T any] struct {
+	q     *Queue[T]
+	index int
+}
+
+func (i *iterator[T]) Next() bool {
+	if i.index < i.q.Len() {
+		i.index++
+	}
+	return i.index < i.q.Len()
+}
+
+func (i *iterator[T]) Value() T {
+	return i.q.Get(i.index)
+}
@@ -1,62 +1,213 @@
 package queue
 
+import (
+	"iter"
+
+	. "github.com/camdencheek/datastructures/iterator"
+)
+
+// Queue is a double-ended queue backed by a growable ring buffer. The zero
+// value is an empty queue ready to use.
 type Queue[T any] struct {
 	data []T
-	start, end int
-}
-
-func (q *Queue[T]) Push(val T) {
-	if 
+	// start is the index in data of the front of the queue
+	start int
+	// length is the number of values stored in the queue
+	length int
 }
 
+// Cap returns the capacity of the queue, or the number
+// of items the queue can store before reallocating
 func (q *Queue[T]) Cap() int {
 	return len(q.data)
 }
 
+// Len returns the current number of items in the queue
 func (q *Queue[T]) Len() int {
-	return q.end - q.start
+	return q.length
 }
 
+// Reserve allocates enough capacity to add at least additional more values to
+// the queue. It does nothing if the capacity of the queue can already handle
+// that many additional values.
 func (q *Queue[T]) Reserve(additional int) {
-	if q.Cap() - q.Len() >= additional {
+	if q.Cap()-q.Len() >= additional {
 		return
-	}	
-	
+	}
+
 	var newCap int
 	switch len(q.data) {
 	case 0, 1, 2:
-		newCap = 4		
+		newCap = 4
 	case 3, 4:
 		newCap = 8
 	default:
 		newCap = len(q.data) * 2
 	}
 
-	if newCap - q.Len() >= additional {
+	if newCap-q.Len() < additional {
 		newCap = q.Len() + additional
 	}
 
+	q.realloc(newCap)
+}
+
+// ShrinkToFit shrinks the capacity of the queue to the length of the queue.
+// If the length already equals capacity, it does nothing.
+func (q *Queue[T]) ShrinkToFit() {
+	if q.Len() == q.Cap() {
+		return
+	}
+	q.realloc(q.Len())
+}
+
+// realloc moves the contents of the queue into a new buffer of the given
+// capacity, unwrapping them so the front of the queue is at index 0.
+func (q *Queue[T]) realloc(newCap int) {
 	newData := make([]T, newCap)
-	copy(newData, q.data[q.start:q.end])
+	if q.start+q.length <= len(q.data) {
+		copy(newData, q.data[q.start:q.start+q.length])
+	} else {
+		n := copy(newData, q.data[q.start:])
+		copy(newData[n:], q.data[:q.length-n])
+	}
 	q.data = newData
-
-	newEnd := q.end - q.start
 	q.start = 0
-	q.end = newEnd
 }
 
-func (q *Queue[T]) Enqueue(val T) {
+// physical converts a logical index into the queue to an index into data.
+func (q *Queue[T]) physical(i int) int {
+	i += q.start
+	if i >= len(q.data) {
+		i -= len(q.data)
+	}
+	return i
+}
+
+// Get returns the value at index i, where index 0 is the front of the queue.
+// Get panics if i is out of range.
+func (q *Queue[T]) Get(i int) T {
+	if i < 0 || i >= q.length {
+		panic("queue: index out of range")
+	}
+	return q.data[q.physical(i)]
+}
+
+// Set replaces the value at index i, where index 0 is the front of the queue.
+// Set panics if i is out of range.
+func (q *Queue[T]) Set(i int, val T) {
+	if i < 0 || i >= q.length {
+		panic("queue: index out of range")
+	}
+	q.data[q.physical(i)] = val
+}
+
+// Iter returns an iterator over the values of the queue from front to back.
+// The queue must not be modified while iterating.
+func (q *Queue[T]) Iter() Iterator[T] {
+	return &iterator[T]{q: q, index: -1}
+}
+
+// All returns a sequence that yields the values of the queue from front to
+// back. The queue must not be modified while iterating.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.length; i++ {
+			if !yield(q.data[q.physical(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence that yields the values of the queue from back
+// to front. The queue must not be modified while iterating.
+func (q *Queue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := q.length - 1; i >= 0; i-- {
+			if !yield(q.data[q.physical(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Front returns a pointer to the value at the front of the queue,
+// or nil if the queue is empty.
+func (q *Queue[T]) Front() *T {
+	if q.length == 0 {
+		return nil
+	}
+	return &q.data[q.start]
+}
+
+// Back returns a pointer to the value at the back of the queue,
+// or nil if the queue is empty.
+func (q *Queue[T]) Back() *T {
+	if q.length == 0 {
+		return nil
+	}
+	return &q.data[q.physical(q.length-1)]
+}
+
+// PushBack adds the value to the back of the queue.
+func (q *Queue[T]) PushBack(val T) {
 	q.Reserve(1)
-	q.data[q.end%len(q.data)] = val
-	q.end++
+	q.data[q.physical(q.length)] = val
+	q.length++
 }
 
-func (q *Queue[T]) Dequeue() *T {
-	if q.Len() == 0 {
+// PushFront adds the value to the front of the queue.
+func (q *Queue[T]) PushFront(val T) {
+	q.Reserve(1)
+	q.start--
+	if q.start < 0 {
+		q.start += len(q.data)
+	}
+	q.data[q.start] = val
+	q.length++
+}
+
+// PopFront removes and returns the value at the front of the queue,
+// or nil if the queue is empty.
+func (q *Queue[T]) PopFront() *T {
+	if q.length == 0 {
 		return nil
 	}
+	var zero T
+	popped := q.data[q.start]
+	q.data[q.start] = zero
+	q.start = q.physical(1)
+	q.length--
+	return &popped
+}
+
+// PopBack removes and returns the value at the back of the queue,
+// or nil if the queue is empty.
+func (q *Queue[T]) PopBack() *T {
+	if q.length == 0 {
+		return nil
+	}
+	var zero T
+	i := q.physical(q.length - 1)
+	popped := q.data[i]
+	q.data[i] = zero
+	q.length--
+	return &popped
+}
 
-	val := q.data[q.start]
-	q.start++
-	return &val
+// Push adds the value to the back of the queue. It is equivalent to PushBack.
+func (q *Queue[T]) Push(val T) {
+	q.PushBack(val)
+}
+
+// Enqueue adds the value to the back of the queue. It is equivalent to PushBack.
+func (q *Queue[T]) Enqueue(val T) {
+	q.PushBack(val)
+}
+
+// Dequeue removes and returns the value at the front of the queue, or nil if
+// the queue is empty. It is equivalent to PopFront.
+func (q *Queue[T]) Dequeue() *T {
+	return q.PopFront()
 }
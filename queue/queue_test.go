@@ -0,0 +1,192 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		var q Queue[int]
+		require.Equal(t, 0, q.Len())
+		require.Nil(t, q.Front())
+		require.Nil(t, q.Back())
+		require.Nil(t, q.PopFront())
+		require.Nil(t, q.PopBack())
+		require.Nil(t, q.Dequeue())
+		require.Panics(t, func() { q.Get(0) })
+	})
+
+	t.Run("PushBack PopFront", func(t *testing.T) {
+		var q Queue[int]
+		q.PushBack(1)
+		q.PushBack(2)
+		q.PushBack(3)
+		require.Equal(t, 1, *q.Front())
+		require.Equal(t, 3, *q.Back())
+		require.Equal(t, 1, *q.PopFront())
+		require.Equal(t, 2, *q.PopFront())
+		require.Equal(t, 3, *q.PopFront())
+		require.Nil(t, q.PopFront())
+	})
+
+	t.Run("PushFront PopBack", func(t *testing.T) {
+		var q Queue[int]
+		q.PushFront(1)
+		q.PushFront(2)
+		q.PushFront(3)
+		require.Equal(t, 3, *q.Front())
+		require.Equal(t, 1, *q.Back())
+		require.Equal(t, 1, *q.PopBack())
+		require.Equal(t, 2, *q.PopBack())
+		require.Equal(t, 3, *q.PopBack())
+		require.Nil(t, q.PopBack())
+	})
+
+	t.Run("Enqueue Dequeue", func(t *testing.T) {
+		var q Queue[int]
+		q.Enqueue(1)
+		q.Push(2)
+		require.Equal(t, 1, *q.Dequeue())
+		require.Equal(t, 2, *q.Dequeue())
+		require.Nil(t, q.Dequeue())
+	})
+
+	t.Run("Iter", func(t *testing.T) {
+		var q Queue[int]
+		q.PushBack(2)
+		q.PushBack(3)
+		q.PushFront(1)
+		iter := q.Iter()
+		var res []int
+		for iter.Next() {
+			res = append(res, iter.Value())
+		}
+		require.Equal(t, []int{1, 2, 3}, res)
+		require.False(t, iter.Next())
+	})
+
+	t.Run("All Backward", func(t *testing.T) {
+		var q Queue[int]
+		q.Reserve(4)
+		q.PushBack(-1)
+		q.PopFront()
+		for i := 1; i <= 4; i++ {
+			q.PushBack(i)
+		}
+		require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(q.All()))
+		require.Equal(t, []int{4, 3, 2, 1}, slices.Collect(q.Backward()))
+	})
+
+	t.Run("Reserve", func(t *testing.T) {
+		var q Queue[int]
+		q.Reserve(100)
+		require.GreaterOrEqual(t, q.Cap(), 100)
+		q.Reserve(1)
+		require.Equal(t, 0, q.Len())
+	})
+
+	t.Run("grows while wrapped at every capacity step", func(t *testing.T) {
+		for capacity := 1; capacity <= 64; capacity++ {
+			for offset := 0; offset < capacity; offset++ {
+				var q Queue[int]
+				q.Reserve(capacity)
+				c := q.Cap()
+
+				// Advance start so the contents wrap around the end of the buffer
+				for i := 0; i < offset; i++ {
+					q.PushBack(-1)
+					q.PopFront()
+				}
+
+				var expected []int
+				for i := 0; i < c+1; i++ {
+					q.PushBack(i)
+					expected = append(expected, i)
+				}
+				require.Greater(t, q.Cap(), c)
+				require.True(t, equivalent(t, &q, expected), "capacity %d offset %d", c, offset)
+			}
+		}
+	})
+
+	t.Run("ShrinkToFit while wrapped", func(t *testing.T) {
+		for offset := 0; offset < 8; offset++ {
+			var q Queue[int]
+			q.Reserve(8)
+			for i := 0; i < offset; i++ {
+				q.PushBack(-1)
+				q.PopFront()
+			}
+			for i := 0; i < 5; i++ {
+				q.PushBack(i)
+			}
+			q.ShrinkToFit()
+			require.Equal(t, 5, q.Cap())
+			require.True(t, equivalent(t, &q, []int{0, 1, 2, 3, 4}))
+		}
+	})
+
+	t.Run("quick check matches slice deque", func(t *testing.T) {
+		f := func(ops []int8) bool {
+			var (
+				q        Queue[int]
+				expected []int
+			)
+			for i, op := range ops {
+				switch op % 4 {
+				case 0:
+					q.PushBack(i)
+					expected = append(expected, i)
+				case 1, -1:
+					q.PushFront(i)
+					expected = append([]int{i}, expected...)
+				case 2, -2:
+					popped := q.PopFront()
+					if len(expected) == 0 {
+						if popped != nil {
+							return false
+						}
+						continue
+					}
+					if popped == nil || *popped != expected[0] {
+						return false
+					}
+					expected = expected[1:]
+				case 3, -3:
+					popped := q.PopBack()
+					if len(expected) == 0 {
+						if popped != nil {
+							return false
+						}
+						continue
+					}
+					if popped == nil || *popped != expected[len(expected)-1] {
+						return false
+					}
+					expected = expected[:len(expected)-1]
+				}
+			}
+			return equivalent(t, &q, expected)
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+}
+
+// equivalent checks that the queue holds the same values as the slice,
+// both through Get and through Front and Back.
+func equivalent(t *testing.T, q *Queue[int], s []int) bool {
+	t.Helper()
+	require.Equal(t, len(s), q.Len())
+	for i, val := range s {
+		require.Equal(t, val, q.Get(i))
+	}
+	if len(s) > 0 {
+		require.Equal(t, s[0], *q.Front())
+		require.Equal(t, s[len(s)-1], *q.Back())
+	}
+	return !t.Failed()
+}
@@ -0,0 +1,159 @@
+package queue
+
+import (
+	"sync/atomic"
+)
+
+// cacheLineSize is a conservative estimate of the cache line size, used to
+// keep the producer's and consumer's indices from sharing a cache line.
+const cacheLineSize = 64
+
+// SPSC is a fixed-capacity, lock-free ring buffer for exactly one producer
+// goroutine and one consumer goroutine. Push and PushN may only be called by
+// the producer, and Pop and PopN may only be called by the consumer.
+type SPSC[T any] struct {
+	_ [cacheLineSize]byte
+
+	// head is the index of the next value to pop. It is only written by the
+	// consumer.
+	head uint64
+	// cachedTail is the consumer's last observed value of tail.
+	cachedTail uint64
+	_          [cacheLineSize - 16]byte
+
+	// tail is the index of the next value to push. It is only written by the
+	// producer.
+	tail uint64
+	// cachedHead is the producer's last observed value of head.
+	cachedHead uint64
+	_          [cacheLineSize - 16]byte
+
+	mask uint64
+	data []T
+}
+
+// NewSPSC creates a new single-producer, single-consumer ring buffer. The
+// capacity is rounded up to the next power of two. NewSPSC panics if
+// capacity is less than one.
+func NewSPSC[T any](capacity int) *SPSC[T] {
+	if capacity < 1 {
+		panic("queue: SPSC capacity must be positive")
+	}
+	size := uint64(1)
+	for size < uint64(capacity) {
+		size <<= 1
+	}
+	return &SPSC[T]{
+		mask: size - 1,
+		data: make([]T, size),
+	}
+}
+
+// Cap returns the number of values the ring buffer can hold.
+func (s *SPSC[T]) Cap() int {
+	return len(s.data)
+}
+
+// Len returns the number of values in the ring buffer. If called
+// concurrently with Push or Pop, the result may already be stale.
+func (s *SPSC[T]) Len() int {
+	head := atomic.LoadUint64(&s.head)
+	tail := atomic.LoadUint64(&s.tail)
+	return int(tail - head)
+}
+
+// Push adds the value to the ring buffer. It returns false without blocking
+// if the ring buffer is full.
+func (s *SPSC[T]) Push(val T) bool {
+	tail := atomic.LoadUint64(&s.tail)
+	if tail-s.cachedHead == uint64(len(s.data)) {
+		s.cachedHead = atomic.LoadUint64(&s.head)
+		if tail-s.cachedHead == uint64(len(s.data)) {
+			return false
+		}
+	}
+	s.data[tail&s.mask] = val
+	atomic.StoreUint64(&s.tail, tail+1)
+	return true
+}
+
+// PushN adds as many values from vals to the ring buffer as there is room
+// for, in order, and returns the number of values added.
+func (s *SPSC[T]) PushN(vals []T) int {
+	tail := atomic.LoadUint64(&s.tail)
+	free := uint64(len(s.data)) - (tail - s.cachedHead)
+	if free < uint64(len(vals)) {
+		s.cachedHead = atomic.LoadUint64(&s.head)
+		free = uint64(len(s.data)) - (tail - s.cachedHead)
+	}
+	n := uint64(len(vals))
+	if n > free {
+		n = free
+	}
+	if n == 0 {
+		return 0
+	}
+
+	// Copy in at most two segments, splitting where the buffer wraps
+	start := tail & s.mask
+	copied := uint64(copy(s.data[start:], vals[:n]))
+	copy(s.data, vals[copied:n])
+
+	atomic.StoreUint64(&s.tail, tail+n)
+	return int(n)
+}
+
+// Pop removes and returns the oldest value in the ring buffer. It returns
+// false without blocking if the ring buffer is empty.
+func (s *SPSC[T]) Pop() (res T, ok bool) {
+	head := atomic.LoadUint64(&s.head)
+	if head == s.cachedTail {
+		s.cachedTail = atomic.LoadUint64(&s.tail)
+		if head == s.cachedTail {
+			return res, false
+		}
+	}
+	i := head & s.mask
+	res = s.data[i]
+	var zero T
+	s.data[i] = zero
+	atomic.StoreUint64(&s.head, head+1)
+	return res, true
+}
+
+// PopN removes up to len(dst) of the oldest values from the ring buffer into
+// dst, in order, and returns the number of values removed.
+func (s *SPSC[T]) PopN(dst []T) int {
+	head := atomic.LoadUint64(&s.head)
+	available := s.cachedTail - head
+	if available < uint64(len(dst)) {
+		s.cachedTail = atomic.LoadUint64(&s.tail)
+		available = s.cachedTail - head
+	}
+	n := uint64(len(dst))
+	if n > available {
+		n = available
+	}
+	if n == 0 {
+		return 0
+	}
+
+	// Copy out in at most two segments, splitting where the buffer wraps
+	start := head & s.mask
+	end := start + n
+	if end > uint64(len(s.data)) {
+		end = uint64(len(s.data))
+	}
+	copied := uint64(copy(dst, s.data[start:end]))
+	copy(dst[copied:n], s.data[:n-copied])
+	var zero T
+	for i := start; i < end; i++ {
+		s.data[i] = zero
+	}
+	for i := uint64(0); i < n-copied; i++ {
+		s.data[i] = zero
+	}
+
+	atomic.StoreUint64(&s.head, head+n)
+	return int(n)
+}
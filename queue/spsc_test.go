@@ -0,0 +1,166 @@
+package queue
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSPSC(t *testing.T) {
+	t.Run("capacity rounds up to a power of two", func(t *testing.T) {
+		require.Equal(t, 1, NewSPSC[int](1).Cap())
+		require.Equal(t, 4, NewSPSC[int](3).Cap())
+		require.Equal(t, 8, NewSPSC[int](8).Cap())
+		require.Panics(t, func() { NewSPSC[int](0) })
+	})
+
+	t.Run("Push Pop", func(t *testing.T) {
+		s := NewSPSC[int](4)
+		_, ok := s.Pop()
+		require.False(t, ok)
+		for i := 0; i < 4; i++ {
+			require.True(t, s.Push(i))
+		}
+		require.False(t, s.Push(4))
+		require.Equal(t, 4, s.Len())
+		for i := 0; i < 4; i++ {
+			val, ok := s.Pop()
+			require.True(t, ok)
+			require.Equal(t, i, val)
+		}
+		_, ok = s.Pop()
+		require.False(t, ok)
+	})
+
+	t.Run("PushN PopN wrap around", func(t *testing.T) {
+		s := NewSPSC[int](8)
+		dst := make([]int, 8)
+		next, expected := 0, 0
+		for round := 0; round < 20; round++ {
+			vals := make([]int, round%11)
+			for i := range vals {
+				vals[i] = next + i
+			}
+			pushed := s.PushN(vals)
+			require.LessOrEqual(t, pushed, len(vals))
+			next += pushed
+
+			popped := s.PopN(dst[:round%7])
+			for _, val := range dst[:popped] {
+				require.Equal(t, expected, val)
+				expected++
+			}
+			require.Equal(t, next-expected, s.Len())
+		}
+	})
+
+	t.Run("concurrent producer and consumer", func(t *testing.T) {
+		const n = 100000
+		s := NewSPSC[int](16)
+		go func() {
+			batch := make([]int, 0, 5)
+			for i := 0; i < n; {
+				if i%3 == 0 {
+					if s.Push(i) {
+						i++
+					} else {
+						runtime.Gosched()
+					}
+					continue
+				}
+				batch = batch[:0]
+				for j := i; j < n && len(batch) < cap(batch); j++ {
+					batch = append(batch, j)
+				}
+				pushed := s.PushN(batch)
+				if pushed == 0 {
+					runtime.Gosched()
+				}
+				i += pushed
+			}
+		}()
+
+		dst := make([]int, 7)
+		for expected := 0; expected < n; {
+			if expected%2 == 0 {
+				val, ok := s.Pop()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+				require.Equal(t, expected, val)
+				expected++
+				continue
+			}
+			popped := s.PopN(dst)
+			if popped == 0 {
+				runtime.Gosched()
+			}
+			for _, val := range dst[:popped] {
+				require.Equal(t, expected, val)
+				expected++
+			}
+		}
+	})
+}
+
+func BenchmarkSPSC(b *testing.B) {
+	b.Run("SPSC", func(b *testing.B) {
+		s := NewSPSC[int](1024)
+		go func() {
+			for i := 0; i < b.N; {
+				if s.Push(i) {
+					i++
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+		for i := 0; i < b.N; {
+			if _, ok := s.Pop(); ok {
+				i++
+			} else {
+				runtime.Gosched()
+			}
+		}
+	})
+
+	b.Run("SPSC batch", func(b *testing.B) {
+		s := NewSPSC[int](1024)
+		go func() {
+			batch := make([]int, 64)
+			for i := 0; i < b.N; {
+				n := len(batch)
+				if b.N-i < n {
+					n = b.N - i
+				}
+				pushed := s.PushN(batch[:n])
+				if pushed == 0 {
+					runtime.Gosched()
+				}
+				i += pushed
+			}
+		}()
+		dst := make([]int, 64)
+		for i := 0; i < b.N; {
+			popped := s.PopN(dst)
+			if popped == 0 {
+				runtime.Gosched()
+			}
+			i += popped
+		}
+	})
+
+	b.Run("channel", func(b *testing.B) {
+		c := make(chan int, 1024)
+		go func() {
+			for i := 0; i < b.N; i++ {
+				c <- i
+			}
+		}()
+		for i := 0; i < b.N; i++ {
+			<-c
+		}
+	})
+}
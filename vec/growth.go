@@ -0,0 +1,102 @@
+package vec
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// GrowthStrategy chooses the new capacity of a vec that needs room for
+// required values but only has capacity for capacity. Returning less than
+// required is treated as required.
+type GrowthStrategy func(capacity, required int) int
+
+// GrowDouble is a GrowthStrategy that at least doubles the capacity, so a
+// sequence of reservations runs in amortized constant time per value.
+func GrowDouble(capacity, required int) int {
+	return max(required, 2*capacity)
+}
+
+// GrowOneAndHalf is a GrowthStrategy that grows the capacity by at least
+// half. It wastes less memory than GrowDouble at the cost of more frequent
+// reallocations.
+func GrowOneAndHalf(capacity, required int) int {
+	return max(required, capacity+capacity/2)
+}
+
+// GrowExact is a GrowthStrategy that allocates only the required capacity.
+func GrowExact(capacity, required int) int {
+	return required
+}
+
+var (
+	// ErrCapacityOverflow is returned when the requested capacity does not
+	// fit in an int.
+	ErrCapacityOverflow = errors.New("vec: capacity overflow")
+	// ErrBudgetExceeded is returned when an allocation would exceed the
+	// memory budget.
+	ErrBudgetExceeded = errors.New("vec: allocation exceeds memory budget")
+)
+
+// Budget is the maximum size in bytes of a vec's backing array.
+type Budget int
+
+// TryReserve is like Reserve, but returns an error instead of allocating a
+// backing array larger than budget. If doubling the capacity would exceed
+// the budget but the required capacity fits, it allocates exactly the
+// required capacity. On error, the vec is unchanged.
+func (v *Vec[T]) TryReserve(additional int, budget Budget) error {
+	return v.TryReserveWith(additional, GrowDouble, budget)
+}
+
+// TryReserveExact is like ReserveExact, but returns an error instead of
+// allocating a backing array larger than budget. On error, the vec is
+// unchanged.
+func (v *Vec[T]) TryReserveExact(additional int, budget Budget) error {
+	return v.TryReserveWith(additional, GrowExact, budget)
+}
+
+// TryReserveWith is like ReserveWith, but returns an error instead of
+// allocating a backing array larger than budget. If the capacity chosen by
+// strategy would exceed the budget but the required capacity fits, it
+// allocates exactly the required capacity. A negative additional is treated
+// as zero. On error, the vec is unchanged.
+func (v *Vec[T]) TryReserveWith(additional int, strategy GrowthStrategy, budget Budget) error {
+	newCap, err := budgetedCap[T](len(*v), cap(*v), additional, strategy, budget)
+	if err != nil {
+		return err
+	}
+	if newCap > cap(*v) {
+		v.realloc(newCap)
+	}
+	return nil
+}
+
+// budgetedCap returns the capacity that TryReserveWith grows a vec with the
+// given length and capacity to, which is the current capacity if it already
+// has room for additional more values. Like ReserveWith, it treats a
+// negative additional as zero.
+func budgetedCap[T any](length, capacity, additional int, strategy GrowthStrategy, budget Budget) (int, error) {
+	if additional <= 0 {
+		return capacity, nil
+	}
+	required := length + additional
+	if required < length {
+		return 0, ErrCapacityOverflow
+	}
+	if capacity >= required {
+		return capacity, nil
+	}
+	var zero T
+	size := int(unsafe.Sizeof(zero))
+	fits := func(capacity int) bool {
+		return size == 0 || capacity <= int(budget)/size
+	}
+	if !fits(required) {
+		return 0, ErrBudgetExceeded
+	}
+	newCap := max(strategy(capacity, required), required)
+	if !fits(newCap) {
+		newCap = required
+	}
+	return newCap, nil
+}
@@ -0,0 +1,117 @@
+package vec
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrowth(t *testing.T) {
+	t.Run("strategies", func(t *testing.T) {
+		require.Equal(t, 16, GrowDouble(8, 9))
+		require.Equal(t, 20, GrowDouble(8, 20))
+		require.Equal(t, 1, GrowDouble(0, 1))
+		require.Equal(t, 12, GrowOneAndHalf(8, 9))
+		require.Equal(t, 2, GrowOneAndHalf(1, 2))
+		require.Equal(t, 9, GrowExact(8, 9))
+	})
+
+	t.Run("Reserve keeps length", func(t *testing.T) {
+		v := New(1, 2)
+		v.Reserve(10)
+		require.Equal(t, New(1, 2), v)
+		require.GreaterOrEqual(t, v.Cap(), 12)
+	})
+
+	t.Run("Reserve is amortized", func(t *testing.T) {
+		var v Vec[int]
+		reallocs := 0
+		for i := 0; i < 1000; i++ {
+			before := v.Cap()
+			v.Reserve(1)
+			v.Push(i)
+			if v.Cap() != before {
+				reallocs++
+			}
+		}
+		require.LessOrEqual(t, reallocs, 11)
+	})
+
+	t.Run("ReserveExact", func(t *testing.T) {
+		v := make(Vec[int], 2, 4)
+		v.ReserveExact(2)
+		require.Equal(t, 4, v.Cap())
+		v.ReserveExact(3)
+		require.Equal(t, 5, v.Cap())
+		require.Equal(t, 2, v.Len())
+	})
+
+	t.Run("ReserveWith", func(t *testing.T) {
+		v := make(Vec[int], 8)
+		v.ReserveWith(1, GrowOneAndHalf)
+		require.Equal(t, 12, v.Cap())
+		v.ReserveWith(1, func(int, int) int { return 0 })
+		require.Equal(t, 12, v.Cap())
+	})
+
+	t.Run("Grow", func(t *testing.T) {
+		v := make(Vec[int], 4)
+		v.Grow(5)
+		require.Equal(t, 8, v.Cap())
+		v.Grow(3)
+		require.Equal(t, 8, v.Cap())
+		require.Equal(t, 4, v.Len())
+	})
+}
+
+func TestTryReserve(t *testing.T) {
+	t.Run("within budget", func(t *testing.T) {
+		v := make(Vec[int64], 4)
+		require.NoError(t, v.TryReserve(1, 64))
+		require.Equal(t, 8, v.Cap())
+	})
+
+	t.Run("falls back to exact", func(t *testing.T) {
+		v := make(Vec[int64], 4)
+		require.NoError(t, v.TryReserve(1, 40))
+		require.Equal(t, 5, v.Cap())
+	})
+
+	t.Run("exceeds budget", func(t *testing.T) {
+		v := make(Vec[int64], 4)
+		require.ErrorIs(t, v.TryReserve(1, 39), ErrBudgetExceeded)
+		require.ErrorIs(t, v.TryReserveExact(1, 39), ErrBudgetExceeded)
+		require.Equal(t, 4, v.Cap())
+	})
+
+	t.Run("already has capacity", func(t *testing.T) {
+		v := make(Vec[int64], 0, 4)
+		require.NoError(t, v.TryReserve(4, 0))
+	})
+
+	t.Run("negative additional", func(t *testing.T) {
+		v := make(Vec[int64], 2, 4)
+		require.NoError(t, v.TryReserve(-1, 0))
+		require.NoError(t, v.TryReserveExact(math.MinInt, 0))
+		v.Reserve(-1)
+		v.ReserveExact(math.MinInt)
+		require.Equal(t, 4, v.Cap())
+		require.Equal(t, 2, v.Len())
+
+		var s Small[int64, [2]int64]
+		require.NoError(t, s.TryReserve(-1, 0))
+		require.False(t, s.Spilled())
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		v := make(Vec[int64], 1)
+		require.ErrorIs(t, v.TryReserve(math.MaxInt, math.MaxInt), ErrCapacityOverflow)
+	})
+
+	t.Run("zero-sized values", func(t *testing.T) {
+		var v Vec[struct{}]
+		require.NoError(t, v.TryReserveWith(100, GrowOneAndHalf, 0))
+		require.GreaterOrEqual(t, v.Cap(), 100)
+	})
+}
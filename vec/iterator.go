@@ -0,0 +1,77 @@
+package vec
+
+import (
+	"iter"
+)
+
+// Iterator yields the values of a vec in order. It can also yield values
+// from the back of the vec with NextBack, and reports the number of values
+// it has left with Len.
+type Iterator[T any] struct {
+	v Vec[T]
+	// front is the index of the next value Next yields, and back is one
+	// past the index of the next value NextBack yields
+	front, back int
+	current     int
+}
+
+// Iter returns an iterator over the values of the vec. The iterator
+// observes the vec's backing slice, so it must not be used after the
+// vec is modified.
+func (v Vec[T]) Iter() *Iterator[T] {
+	return &Iterator[T]{v: v, back: len(v), current: -1}
+}
+
+// Next advances the iterator and reports whether there is a value to read.
+func (it *Iterator[T]) Next() bool {
+	if it.front >= it.back {
+		return false
+	}
+	it.current = it.front
+	it.front++
+	return true
+}
+
+// NextBack advances the iterator from the back and reports whether there is
+// a value to read. The front and back of the iterator never pass each other.
+func (it *Iterator[T]) NextBack() bool {
+	if it.front >= it.back {
+		return false
+	}
+	it.back--
+	it.current = it.back
+	return true
+}
+
+// Value returns the value most recently yielded by Next or NextBack.
+func (it *Iterator[T]) Value() T {
+	return it.v[it.current]
+}
+
+// Len returns the number of values the iterator has left.
+func (it *Iterator[T]) Len() int {
+	return it.back - it.front
+}
+
+// All returns a sequence that yields the values of the vec in order.
+func (v Vec[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, val := range v {
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns a sequence that yields the values of the vec in
+// reverse order.
+func (v Vec[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(v) - 1; i >= 0; i-- {
+			if !yield(v[i]) {
+				return
+			}
+		}
+	}
+}
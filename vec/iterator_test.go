@@ -0,0 +1,46 @@
+package vec
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterator(t *testing.T) {
+	t.Run("Iter", func(t *testing.T) {
+		it := New(1, 2, 3).Iter()
+		var res []int
+		for it.Next() {
+			res = append(res, it.Value())
+		}
+		require.Equal(t, []int{1, 2, 3}, res)
+		require.False(t, it.Next())
+	})
+
+	t.Run("All", func(t *testing.T) {
+		require.Equal(t, []int{1, 2, 3}, slices.Collect(New(1, 2, 3).All()))
+		require.Nil(t, slices.Collect(New[int]().All()))
+	})
+
+	t.Run("Backward", func(t *testing.T) {
+		require.Equal(t, []int{3, 2, 1}, slices.Collect(New(1, 2, 3).Backward()))
+	})
+}
+
+func TestIteratorDoubleEnded(t *testing.T) {
+	it := New(1, 2, 3, 4).Iter()
+	require.Equal(t, 4, it.Len())
+	require.True(t, it.NextBack())
+	require.Equal(t, 4, it.Value())
+	require.True(t, it.Next())
+	require.Equal(t, 1, it.Value())
+	require.Equal(t, 2, it.Len())
+	require.True(t, it.NextBack())
+	require.Equal(t, 3, it.Value())
+	require.True(t, it.Next())
+	require.Equal(t, 2, it.Value())
+	require.Equal(t, 0, it.Len())
+	require.False(t, it.Next())
+	require.False(t, it.NextBack())
+}
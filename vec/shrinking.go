@@ -0,0 +1,131 @@
+package vec
+
+import (
+	"github.com/camdencheek/datastructures/compare"
+)
+
+// Shrinking is a vec that stores a ShrinkPolicy and applies it after every
+// operation that removes values, so its capacity follows its length back
+// down and a vec that was briefly large does not hold on to a large backing
+// array:
+//
+//	s := vec.NewShrinking[int](vec.ShrinkQuarter)
+//	s.AppendSlice(make([]int, 1024))
+//	s.Truncate(10) // the capacity is now 32
+//
+// The zero value is an empty Shrinking that uses ShrinkQuarter.
+//
+// The embedded Vec gives access to the values and to every operation that
+// does not remove them. Values removed through the Vec directly, or by
+// functions such as Dedup that take a *Vec, are not followed by a shrink;
+// call Shrink afterwards.
+type Shrinking[T any] struct {
+	Vec[T]
+	policy ShrinkPolicy
+}
+
+// NewShrinking returns a Shrinking that holds the given values and shrinks
+// with policy. A nil policy means ShrinkQuarter.
+func NewShrinking[T any](policy ShrinkPolicy, vals ...T) *Shrinking[T] {
+	return &Shrinking[T]{Vec: New(vals...), policy: policy}
+}
+
+// Shrink shrinks the capacity of the vec to the capacity chosen by its
+// policy, but never below its length.
+func (s *Shrinking[T]) Shrink() {
+	if s.policy == nil {
+		s.Vec.ShrinkWith(ShrinkQuarter)
+		return
+	}
+	s.Vec.ShrinkWith(s.policy)
+}
+
+// Clear removes all values from the vec, then shrinks it.
+func (s *Shrinking[T]) Clear() {
+	s.Vec.Clear()
+	s.Shrink()
+}
+
+// Pop removes and returns the last value from the vec, or nil if the vec
+// has no values, then shrinks it.
+func (s *Shrinking[T]) Pop() *T {
+	popped := s.Vec.Pop()
+	s.Shrink()
+	return popped
+}
+
+// PopOrZero removes and returns the last value from the vec, or the zero
+// value of T if the vec has no values, then shrinks it.
+func (s *Shrinking[T]) PopOrZero() T {
+	popped := s.Vec.PopOrZero()
+	s.Shrink()
+	return popped
+}
+
+// Remove removes the value at index i from the vec, shifting all values
+// after it to the left, then shrinks it.
+func (s *Shrinking[T]) Remove(i int) {
+	s.Vec.Remove(i)
+	s.Shrink()
+}
+
+// RemoveRange removes the values at indexes i through j-1, shifting all
+// values after them to the left, then shrinks the vec. RemoveRange panics if
+// the range is out of bounds.
+func (s *Shrinking[T]) RemoveRange(i, j int) {
+	s.Vec.RemoveRange(i, j)
+	s.Shrink()
+}
+
+// SwapRemove removes and returns the value at index i, replacing it with the
+// last value of the vec, then shrinks it. SwapRemove panics if i is out of
+// range.
+func (s *Shrinking[T]) SwapRemove(i int) T {
+	removed := s.Vec.SwapRemove(i)
+	s.Shrink()
+	return removed
+}
+
+// Splice replaces the values at indexes i through j-1 with the values of
+// replacement, then shrinks the vec. Splice panics if the range is out of
+// bounds.
+func (s *Shrinking[T]) Splice(i, j int, replacement []T) {
+	s.Vec.Splice(i, j, replacement)
+	s.Shrink()
+}
+
+// Drain removes the values at indexes i through j-1 from the vec and returns
+// an iterator over them, then shrinks the vec. Drain panics if the range is
+// out of bounds.
+func (s *Shrinking[T]) Drain(i, j int) *Iterator[T] {
+	drained := s.Vec.Drain(i, j)
+	s.Shrink()
+	return drained
+}
+
+// Truncate keeps size values, removing all values after that, then shrinks
+// the vec.
+func (s *Shrinking[T]) Truncate(size int) {
+	s.Vec.Truncate(size)
+	s.Shrink()
+}
+
+// Retain keeps only the values for which pred returns true, preserving
+// their order, then shrinks the vec.
+func (s *Shrinking[T]) Retain(pred func(T) bool) {
+	s.Vec.Retain(pred)
+	s.Shrink()
+}
+
+// Filter removes all values for which the predicate does not return true,
+// then shrinks the vec. It is equivalent to Retain.
+func (s *Shrinking[T]) Filter(predicate func(T) bool) {
+	s.Retain(predicate)
+}
+
+// DedupBy removes consecutive values that cmp reports as equal, keeping the
+// first of each run, then shrinks the vec.
+func (s *Shrinking[T]) DedupBy(cmp compare.CompareFunc[T]) {
+	s.Vec.DedupBy(cmp)
+	s.Shrink()
+}
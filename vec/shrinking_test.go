@@ -0,0 +1,66 @@
+package vec
+
+import (
+	"runtime"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/camdencheek/datastructures/compare"
+)
+
+func TestShrinking(t *testing.T) {
+	t.Run("zero value uses ShrinkQuarter", func(t *testing.T) {
+		var s Shrinking[int]
+		s.AppendSlice(make([]int, 1024))
+		require.Equal(t, 1024, s.Cap())
+		s.Truncate(10)
+		require.Equal(t, 10, s.Len())
+		require.Equal(t, 32, s.Cap())
+	})
+
+	t.Run("custom policy", func(t *testing.T) {
+		s := NewShrinking(func(length, _ int) int { return length }, 1, 2, 3)
+		s.Reserve(10)
+		s.Pop()
+		require.Equal(t, New(1, 2), s.Vec)
+		require.Equal(t, 2, s.Cap())
+	})
+
+	shrinkers := map[string]func(*Shrinking[int]){
+		"Clear":       func(s *Shrinking[int]) { s.Clear() },
+		"Pop":         func(s *Shrinking[int]) { s.Pop() },
+		"PopOrZero":   func(s *Shrinking[int]) { s.PopOrZero() },
+		"Remove":      func(s *Shrinking[int]) { s.Remove(0) },
+		"RemoveRange": func(s *Shrinking[int]) { s.RemoveRange(0, 2) },
+		"SwapRemove":  func(s *Shrinking[int]) { s.SwapRemove(0) },
+		"Splice":      func(s *Shrinking[int]) { s.Splice(0, 2, nil) },
+		"Drain":       func(s *Shrinking[int]) { s.Drain(0, 2) },
+		"Truncate":    func(s *Shrinking[int]) { s.Truncate(1) },
+		"Retain":      func(s *Shrinking[int]) { s.Retain(isEven) },
+		"Filter":      func(s *Shrinking[int]) { s.Filter(isEven) },
+		"DedupBy":     func(s *Shrinking[int]) { s.DedupBy(func(int, int) compare.Result { return compare.Equal }) },
+	}
+	for name, shrink := range shrinkers {
+		t.Run(name+" shrinks", func(t *testing.T) {
+			s := NewShrinking[int](nil, 1, 2, 3)
+			s.Reserve(61)
+			require.Equal(t, 64, s.Cap())
+			shrink(s)
+			require.Less(t, s.Cap(), 64)
+			require.Equal(t, ShrinkQuarter(s.Len(), 64), s.Cap())
+		})
+	}
+
+	t.Run("releases the old array", func(t *testing.T) {
+		var released atomic.Int32
+		s := Shrinking[*tracked]{Vec: newTrackedVec(16, &released)}
+		for i := 0; i < 15; i++ {
+			s.Pop()
+		}
+		requireReleased(t, &released, 15)
+		require.Equal(t, 4, s.Cap())
+		runtime.KeepAlive(s)
+	})
+}
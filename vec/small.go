@@ -0,0 +1,473 @@
+package vec
+
+import (
+	"iter"
+	"slices"
+	"unsafe"
+
+	"github.com/camdencheek/datastructures/compare"
+)
+
+// Inline is the set of array types a Small can store its values in. The
+// length of the array is the number of values stored without allocating.
+type Inline[T any] interface {
+	[1]T | [2]T | [4]T | [8]T | [16]T | [32]T | [64]T
+}
+
+// Small is a vec that stores up to len(A) values inline in a fixed array,
+// and only moves them to a heap-allocated Vec when it grows beyond that.
+// Short-lived lists that usually hold a few values can therefore avoid
+// allocating a backing array at all:
+//
+//	var s vec.Small[int, [8]int]
+//	s.Push(1) // no allocation until the ninth value
+//
+// The zero value is an empty Small ready to use. A Small must not be copied
+// after first use, since a copy would share the heap-allocated values; use
+// Copy instead.
+type Small[T any, A Inline[T]] struct {
+	inline A
+	// n is the number of values stored inline, used while heap is nil
+	n int
+	// heap holds the values once they no longer fit inline
+	heap Vec[T]
+}
+
+// inlineArray returns the inline array as a slice with length and capacity
+// len(A). Type parameters with array types of different lengths cannot be
+// indexed or sliced, so the array is viewed through a pointer instead.
+func (s *Small[T, A]) inlineArray() []T {
+	return unsafe.Slice((*T)(unsafe.Pointer(&s.inline)), len(s.inline))
+}
+
+// inlineVec returns a Vec aliasing the values stored inline. Its capacity
+// is len(A).
+func (s *Small[T, A]) inlineVec() Vec[T] {
+	return s.inlineArray()[:s.n]
+}
+
+// vec returns a Vec that aliases the values of the small vec. It is only
+// used for operations that do not change the length.
+func (s *Small[T, A]) vec() Vec[T] {
+	if s.heap != nil {
+		return s.heap
+	}
+	return s.inlineVec()
+}
+
+// Every operation that changes the length works on either s.heap, through
+// the Vec method of the same name, or directly on the inline array. The
+// inline array is never passed to Vec methods with pointer receivers or
+// assigned to s.heap, since escape analysis would then move every Small to
+// the heap, defeating its purpose. Operations that may grow the vec call
+// spillFor first so the inline array never needs to be reallocated.
+
+// spillFor moves the values to the heap if required values do not fit
+// inline, choosing the capacity with strategy.
+func (s *Small[T, A]) spillFor(required int, strategy GrowthStrategy) {
+	if s.heap == nil && required > len(s.inline) {
+		s.spill(max(strategy(len(s.inline), required), required))
+	}
+}
+
+// spill moves the values into a new heap-allocated Vec with the given
+// capacity, zeroing the inline array so it does not keep values alive.
+func (s *Small[T, A]) spill(capacity int) {
+	heap := make(Vec[T], s.n, capacity)
+	inline := s.inlineVec()
+	copy(heap, inline)
+	clear(inline)
+	s.n = 0
+	s.heap = heap
+}
+
+// unspill moves the values back into the inline array if they fit.
+func (s *Small[T, A]) unspill() {
+	if s.heap == nil || len(s.heap) > len(s.inline) {
+		return
+	}
+	s.n = copy(s.inlineArray(), s.heap)
+	s.heap = nil
+}
+
+// popInline removes and returns the last inline value, zeroing the slot it
+// leaves behind.
+func (s *Small[T, A]) popInline() T {
+	inline := s.inlineArray()
+	s.n--
+	popped := inline[s.n]
+	var zero T
+	inline[s.n] = zero
+	return popped
+}
+
+// Spilled reports whether the values are stored on the heap rather than
+// inline.
+func (s *Small[T, A]) Spilled() bool {
+	return s.heap != nil
+}
+
+// Cap returns the capacity of the small vec, or the number of items it can
+// store before reallocating.
+func (s *Small[T, A]) Cap() int {
+	if s.heap != nil {
+		return cap(s.heap)
+	}
+	return len(s.inline)
+}
+
+// Len returns the current number of items in the small vec.
+func (s *Small[T, A]) Len() int {
+	if s.heap != nil {
+		return len(s.heap)
+	}
+	return s.n
+}
+
+// Get returns the value at index i. Get panics if i is out of range.
+func (s *Small[T, A]) Get(i int) T {
+	return s.vec()[i]
+}
+
+// Set replaces the value at index i. Set panics if i is out of range.
+func (s *Small[T, A]) Set(i int, val T) {
+	s.vec()[i] = val
+}
+
+// Append appends the values of other to the end of the small vec.
+func (s *Small[T, A]) Append(other Vec[T]) {
+	s.AppendSlice(other)
+}
+
+// AppendSlice appends the values of other to the end of the small vec.
+func (s *Small[T, A]) AppendSlice(other []T) {
+	s.spillFor(s.Len()+len(other), GrowDouble)
+	if s.heap != nil {
+		s.heap.AppendSlice(other)
+		return
+	}
+	s.n += copy(s.inlineArray()[s.n:], other)
+}
+
+// Clear removes all values from the small vec without changing its
+// capacity.
+func (s *Small[T, A]) Clear() {
+	if s.heap != nil {
+		s.heap.Clear()
+		return
+	}
+	clear(s.inlineVec())
+	s.n = 0
+}
+
+// Insert inserts the value at index i, shifting all values after it to the
+// right. Insert panics if i > s.Len().
+func (s *Small[T, A]) Insert(i int, val T) {
+	s.spillFor(s.Len()+1, GrowDouble)
+	if s.heap != nil {
+		s.heap.Insert(i, val)
+		return
+	}
+	s.n = len(slices.Insert(s.inlineVec(), i, val))
+}
+
+// InsertSlice inserts the values at index i, shifting all values after it
+// to the right. InsertSlice panics if i > s.Len().
+func (s *Small[T, A]) InsertSlice(i int, vals []T) {
+	s.spillFor(s.Len()+len(vals), GrowDouble)
+	if s.heap != nil {
+		s.heap.InsertSlice(i, vals)
+		return
+	}
+	s.n = len(slices.Insert(s.inlineVec(), i, vals...))
+}
+
+// Push adds the value to the end of the small vec.
+func (s *Small[T, A]) Push(val T) {
+	s.spillFor(s.Len()+1, GrowDouble)
+	if s.heap != nil {
+		s.heap.Push(val)
+		return
+	}
+	s.inlineArray()[s.n] = val
+	s.n++
+}
+
+// Pop removes and returns the last value, or nil if the small vec is empty.
+func (s *Small[T, A]) Pop() *T {
+	if s.heap != nil {
+		return s.heap.Pop()
+	}
+	if s.n == 0 {
+		return nil
+	}
+	popped := s.popInline()
+	return &popped
+}
+
+// PopOrZero removes and returns the last value, or the zero value of T if
+// the small vec is empty.
+func (s *Small[T, A]) PopOrZero() T {
+	if s.heap != nil {
+		return s.heap.PopOrZero()
+	}
+	if s.n == 0 {
+		var zero T
+		return zero
+	}
+	return s.popInline()
+}
+
+// Remove removes the value at index i, shifting all values after it to the
+// left.
+func (s *Small[T, A]) Remove(i int) {
+	if s.heap != nil {
+		s.heap.Remove(i)
+		return
+	}
+	s.n = len(slices.Delete(s.inlineVec(), i, i+1))
+}
+
+// RemoveRange removes the values at indexes i through j-1, shifting all
+// values after them to the left.
+func (s *Small[T, A]) RemoveRange(i, j int) {
+	if s.heap != nil {
+		s.heap.RemoveRange(i, j)
+		return
+	}
+	s.n = len(slices.Delete(s.inlineVec(), i, j))
+}
+
+// Splice replaces the values at indexes i through j-1 with the values of
+// replacement.
+func (s *Small[T, A]) Splice(i, j int, replacement []T) {
+	s.spillFor(s.Len()-(j-i)+len(replacement), GrowDouble)
+	if s.heap != nil {
+		s.heap.Splice(i, j, replacement)
+		return
+	}
+	s.n = len(slices.Replace(s.inlineVec(), i, j, replacement...))
+}
+
+// SwapRemove removes and returns the value at index i, replacing it with the
+// last value.
+func (s *Small[T, A]) SwapRemove(i int) T {
+	if s.heap != nil {
+		return s.heap.SwapRemove(i)
+	}
+	inline := s.inlineVec()
+	removed := inline[i]
+	last := s.popInline()
+	if i < s.n {
+		inline[i] = last
+	}
+	return removed
+}
+
+// Drain removes the values at indexes i through j-1 and returns an iterator
+// over them.
+func (s *Small[T, A]) Drain(i, j int) *Iterator[T] {
+	if s.heap != nil {
+		return s.heap.Drain(i, j)
+	}
+	inline := s.inlineVec()
+	drained := NewFromSlice(slices.Clone(inline[i:j]))
+	s.n = len(slices.Delete(inline, i, j))
+	return drained.Iter()
+}
+
+// Filter removes all values for which the predicate does not return true.
+// It is equivalent to Retain.
+func (s *Small[T, A]) Filter(predicate func(T) bool) {
+	s.Retain(predicate)
+}
+
+// Retain keeps only the values for which pred returns true, preserving
+// their order.
+func (s *Small[T, A]) Retain(pred func(T) bool) {
+	if s.heap != nil {
+		s.heap.Retain(pred)
+		return
+	}
+	s.n = len(slices.DeleteFunc(s.inlineVec(), func(val T) bool { return !pred(val) }))
+}
+
+// DedupBy removes consecutive values that cmp reports as equal, keeping the
+// first of each run.
+func (s *Small[T, A]) DedupBy(cmp compare.CompareFunc[T]) {
+	if s.heap != nil {
+		s.heap.DedupBy(cmp)
+		return
+	}
+	s.n = len(slices.CompactFunc(s.inlineVec(), cmp.Equal))
+}
+
+// Truncate keeps size values, removing all values after that.
+func (s *Small[T, A]) Truncate(size int) {
+	if s.heap != nil {
+		s.heap.Truncate(size)
+		return
+	}
+	if s.n <= size {
+		return
+	}
+	clear(s.inlineVec()[size:])
+	s.n = size
+}
+
+// Reserve allocates enough capacity to add at least additional more values,
+// growing with GrowDouble. Values move to the heap if they no longer fit
+// inline.
+func (s *Small[T, A]) Reserve(additional int) {
+	s.ReserveWith(additional, GrowDouble)
+}
+
+// ReserveExact allocates capacity for exactly additional more values.
+// Values move to the heap if they no longer fit inline.
+func (s *Small[T, A]) ReserveExact(additional int) {
+	s.ReserveWith(additional, GrowExact)
+}
+
+// ReserveWith allocates enough capacity to add at least additional more
+// values, choosing the new capacity with strategy. Values move to the heap
+// if they no longer fit inline.
+func (s *Small[T, A]) ReserveWith(additional int, strategy GrowthStrategy) {
+	if s.heap != nil {
+		s.heap.ReserveWith(additional, strategy)
+		return
+	}
+	s.spillFor(s.n+additional, strategy)
+}
+
+// TryReserve is like Reserve, but returns an error instead of allocating a
+// backing array larger than budget.
+func (s *Small[T, A]) TryReserve(additional int, budget Budget) error {
+	return s.TryReserveWith(additional, GrowDouble, budget)
+}
+
+// TryReserveExact is like ReserveExact, but returns an error instead of
+// allocating a backing array larger than budget.
+func (s *Small[T, A]) TryReserveExact(additional int, budget Budget) error {
+	return s.TryReserveWith(additional, GrowExact, budget)
+}
+
+// TryReserveWith is like ReserveWith, but returns an error instead of
+// allocating a backing array larger than budget.
+func (s *Small[T, A]) TryReserveWith(additional int, strategy GrowthStrategy, budget Budget) error {
+	if s.heap != nil {
+		return s.heap.TryReserveWith(additional, strategy, budget)
+	}
+	newCap, err := budgetedCap[T](s.n, len(s.inline), additional, strategy, budget)
+	if err != nil {
+		return err
+	}
+	if newCap > len(s.inline) {
+		s.spill(newCap)
+	}
+	return nil
+}
+
+// Grow increases the capacity to at least the given size.
+func (s *Small[T, A]) Grow(size int) {
+	s.Reserve(size - s.Len())
+}
+
+// ShrinkToFit shrinks the capacity to the length of the small vec. If the
+// values fit inline, they move back into the inline array.
+func (s *Small[T, A]) ShrinkToFit() {
+	if s.heap == nil {
+		return
+	}
+	s.unspill()
+	if s.heap != nil {
+		s.heap.ShrinkToFit()
+	}
+}
+
+// ShrinkWith shrinks the capacity to the capacity chosen by policy, but
+// never below the length. If the chosen capacity fits inline, the values
+// move back into the inline array.
+func (s *Small[T, A]) ShrinkWith(policy ShrinkPolicy) {
+	if s.heap == nil {
+		return
+	}
+	if max(policy(len(s.heap), cap(s.heap)), len(s.heap)) <= len(s.inline) {
+		s.unspill()
+		return
+	}
+	s.heap.ShrinkWith(policy)
+}
+
+// AsSlice returns a slice of the values. The slice aliases the storage of
+// the small vec, so it must not be used after the small vec is modified.
+func (s *Small[T, A]) AsSlice() []T {
+	return s.vec()
+}
+
+// AsCopiedSlice copies the values into a new slice and returns it.
+func (s *Small[T, A]) AsCopiedSlice() []T {
+	return s.vec().AsCopiedSlice()
+}
+
+// Copy returns a new small vec that contains all the same values.
+func (s *Small[T, A]) Copy() Small[T, A] {
+	var copied Small[T, A]
+	if s.heap != nil {
+		copied.heap = s.heap.Copy()
+	} else {
+		copied.inline = s.inline
+		copied.n = s.n
+	}
+	return copied
+}
+
+// Iter returns an iterator over the values. It must not be used after the
+// small vec is modified.
+func (s *Small[T, A]) Iter() *Iterator[T] {
+	return s.vec().Iter()
+}
+
+// All returns a sequence that yields the values from first to last.
+func (s *Small[T, A]) All() iter.Seq[T] {
+	return s.vec().All()
+}
+
+// Backward returns a sequence that yields the values from last to first.
+func (s *Small[T, A]) Backward() iter.Seq[T] {
+	return s.vec().Backward()
+}
+
+// Sort sorts the values in ascending order according to cmp. The sort is
+// not stable.
+func (s *Small[T, A]) Sort(cmp compare.CompareFunc[T]) {
+	s.vec().Sort(cmp)
+}
+
+// SortStable sorts the values in ascending order according to cmp, keeping
+// equal values in their original order.
+func (s *Small[T, A]) SortStable(cmp compare.CompareFunc[T]) {
+	s.vec().SortStable(cmp)
+}
+
+// IsSorted reports whether the values are sorted in ascending order
+// according to cmp.
+func (s *Small[T, A]) IsSorted(cmp compare.CompareFunc[T]) bool {
+	return s.vec().IsSorted(cmp)
+}
+
+// BinarySearch searches values sorted according to cmp for x, returning
+// its index and whether it was found, like Vec.BinarySearch.
+func (s *Small[T, A]) BinarySearch(x T, cmp compare.CompareFunc[T]) (int, bool) {
+	return s.vec().BinarySearch(x, cmp)
+}
+
+// PartitionPoint returns the index of the first value for which pred
+// returns false, like Vec.PartitionPoint.
+func (s *Small[T, A]) PartitionPoint(pred func(T) bool) int {
+	return s.vec().PartitionPoint(pred)
+}
+
+// Reverse reverses the order of the values.
+func (s *Small[T, A]) Reverse() {
+	s.vec().Reverse()
+}
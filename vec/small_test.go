@@ -0,0 +1,196 @@
+package vec
+
+import (
+	"slices"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/camdencheek/datastructures/compare"
+)
+
+func TestSmall(t *testing.T) {
+	t.Run("zero value", func(t *testing.T) {
+		var s Small[int, [4]int]
+		require.Equal(t, 0, s.Len())
+		require.Equal(t, 4, s.Cap())
+		require.Nil(t, s.Pop())
+		require.False(t, s.Spilled())
+	})
+
+	t.Run("stays inline without allocating", func(t *testing.T) {
+		var res []int
+		allocs := testing.AllocsPerRun(10, func() {
+			var s Small[int, [4]int]
+			for i := 0; i < 4; i++ {
+				s.Push(i)
+			}
+			s.Remove(1)
+			s.Insert(0, 5)
+			s.SwapRemove(3)
+			s.PopOrZero()
+			s.InsertSlice(1, []int{6, 7})
+			s.RemoveRange(0, 1)
+			s.Splice(0, 1, []int{8})
+			s.Retain(isEven)
+			s.Truncate(3)
+			s.Reserve(1)
+			if s.Spilled() {
+				panic("spilled")
+			}
+			res = append(res[:0], s.AsSlice()...)
+		})
+		require.Zero(t, allocs)
+		require.Equal(t, []int{8, 0}, res)
+	})
+
+	t.Run("spills and shrinks back", func(t *testing.T) {
+		var s Small[*int, [2]*int]
+		a, b, c := new(int), new(int), new(int)
+		s.Push(a)
+		s.Push(b)
+		require.False(t, s.Spilled())
+		s.Push(c)
+		require.True(t, s.Spilled())
+		require.Equal(t, []*int{a, b, c}, s.AsSlice())
+		// The inline array no longer holds the values
+		require.Equal(t, [2]*int{}, s.inline)
+
+		s.Pop()
+		s.ShrinkToFit()
+		require.False(t, s.Spilled())
+		require.Equal(t, []*int{a, b}, s.AsSlice())
+		require.Equal(t, 2, s.Cap())
+	})
+
+	t.Run("ShrinkWith", func(t *testing.T) {
+		var s Small[int, [2]int]
+		s.Reserve(64)
+		s.Push(1)
+		s.ShrinkWith(ShrinkQuarter)
+		require.True(t, s.Spilled())
+		require.Equal(t, 4, s.Cap())
+		s.ShrinkWith(func(int, int) int { return 0 })
+		require.False(t, s.Spilled())
+		require.Equal(t, []int{1}, s.AsSlice())
+	})
+
+	t.Run("Reserve", func(t *testing.T) {
+		var s Small[int, [4]int]
+		s.Reserve(4)
+		require.False(t, s.Spilled())
+		s.ReserveExact(5)
+		require.True(t, s.Spilled())
+		require.Equal(t, 5, s.Cap())
+		require.ErrorIs(t, s.TryReserveExact(10, 8), ErrBudgetExceeded)
+		require.Equal(t, 5, s.Cap())
+	})
+
+	t.Run("Copy", func(t *testing.T) {
+		for _, n := range []int{2, 8} {
+			var s Small[int, [4]int]
+			for i := 0; i < n; i++ {
+				s.Push(i)
+			}
+			copied := s.Copy()
+			copied.Set(0, 10)
+			require.Equal(t, 0, s.Get(0))
+			require.Equal(t, 10, copied.Get(0))
+			require.Equal(t, n, copied.Len())
+		}
+	})
+
+	t.Run("ordering", func(t *testing.T) {
+		ints := compare.Ordered[int]()
+		var s Small[int, [8]int]
+		s.AppendSlice([]int{3, 1, 2, 2})
+		s.Sort(ints)
+		require.True(t, s.IsSorted(ints))
+		s.DedupBy(ints)
+		require.Equal(t, []int{1, 2, 3}, slices.Collect(s.All()))
+		idx, found := s.BinarySearch(3, ints)
+		require.Equal(t, 2, idx)
+		require.True(t, found)
+		require.Equal(t, 1, s.PartitionPoint(func(i int) bool { return i < 2 }))
+		s.Reverse()
+		require.Equal(t, []int{1, 2, 3}, slices.Collect(s.Backward()))
+	})
+
+	t.Run("zero-sized values", func(t *testing.T) {
+		var s Small[struct{}, [2]struct{}]
+		for i := 0; i < 5; i++ {
+			s.Push(struct{}{})
+		}
+		require.Equal(t, 5, s.Len())
+		require.True(t, s.Spilled())
+	})
+
+	t.Run("quick check matches Vec", func(t *testing.T) {
+		f := func(ops []uint8) bool {
+			var (
+				s Small[int, [4]int]
+				v Vec[int]
+			)
+			for i, op := range ops {
+				switch {
+				case op%8 == 0 && v.Len() > 0:
+					at := int(op) % v.Len()
+					v.Remove(at)
+					s.Remove(at)
+				case op%8 == 1 && v.Len() > 0:
+					if *v.Pop() != *s.Pop() {
+						return false
+					}
+				case op%8 == 2:
+					at := int(op) % (v.Len() + 1)
+					v.InsertSlice(at, []int{i, i})
+					s.InsertSlice(at, []int{i, i})
+				case op%8 == 3:
+					v.Truncate(v.Len() / 2)
+					s.Truncate(s.Len() / 2)
+				case op%8 == 4:
+					s.ShrinkToFit()
+				case op%8 == 5 && v.Len() > 0:
+					at := int(op) % v.Len()
+					if v.SwapRemove(at) != s.SwapRemove(at) {
+						return false
+					}
+				case op%8 == 6:
+					v.Retain(isEven)
+					s.Retain(isEven)
+				default:
+					v.Push(i)
+					s.Push(i)
+				}
+				if !slices.Equal(v, s.AsSlice()) || s.Len() != v.Len() || s.Cap() < s.Len() {
+					return false
+				}
+			}
+			return true
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+}
+
+func BenchmarkSmall(b *testing.B) {
+	b.Run("Vec", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var v Vec[int]
+			for j := 0; j < 6; j++ {
+				v.Push(j)
+			}
+		}
+	})
+
+	b.Run("Small", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var s Small[int, [8]int]
+			for j := 0; j < 6; j++ {
+				s.Push(j)
+			}
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package vec
+
+import (
+	"slices"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/camdencheek/datastructures/compare"
+)
+
+// Sort sorts the vec in ascending order according to cmp. The sort is not
+// stable, so equal values may be reordered. It uses pattern-defeating
+// quicksort.
+func (v Vec[T]) Sort(cmp compare.CompareFunc[T]) {
+	slices.SortFunc(v, cmpInt(cmp))
+}
+
+// SortStable sorts the vec in ascending order according to cmp, keeping
+// equal values in their original order.
+func (v Vec[T]) SortStable(cmp compare.CompareFunc[T]) {
+	slices.SortStableFunc(v, cmpInt(cmp))
+}
+
+// SortUnstableBy sorts the vec in ascending order of the key extracted from
+// each value. The sort is not stable. It is a function rather than a method
+// because methods cannot have their own type parameters.
+func SortUnstableBy[T any, K constraints.Ordered](v Vec[T], key func(T) K) {
+	v.Sort(compare.By(key))
+}
+
+// IsSorted reports whether the vec is sorted in ascending order according to
+// cmp.
+func (v Vec[T]) IsSorted(cmp compare.CompareFunc[T]) bool {
+	return slices.IsSortedFunc(v, cmpInt(cmp))
+}
+
+// BinarySearch searches a vec sorted according to cmp for x. It returns the
+// index of x and true if x is found, or the index at which x would be
+// inserted to keep the vec sorted and false if it is not. If several values
+// are equal to x, any of their indexes may be returned.
+func (v Vec[T]) BinarySearch(x T, cmp compare.CompareFunc[T]) (int, bool) {
+	return slices.BinarySearchFunc(v, x, cmpInt(cmp))
+}
+
+// PartitionPoint returns the index of the first value for which pred returns
+// false. The vec must be partitioned by pred, so that every value for which
+// pred returns true comes before every value for which it returns false.
+func (v Vec[T]) PartitionPoint(pred func(T) bool) int {
+	return sort.Search(len(v), func(i int) bool { return !pred(v[i]) })
+}
+
+// DedupBy removes consecutive values that cmp reports as equal, keeping the
+// first of each run.
+func (v *Vec[T]) DedupBy(cmp compare.CompareFunc[T]) {
+	*v = slices.CompactFunc(*v, cmp.Equal)
+}
+
+// Dedup removes consecutive equal values, keeping the first of each run. It
+// is a function rather than a method because it requires comparable values.
+func Dedup[T comparable](v *Vec[T]) {
+	*v = slices.Compact(*v)
+}
+
+// Reverse reverses the order of the values in the vec.
+func (v Vec[T]) Reverse() {
+	slices.Reverse(v)
+}
+
+// cmpInt adapts a CompareFunc to the int-returning comparison functions used
+// by the slices package.
+func cmpInt[T any](cmp compare.CompareFunc[T]) func(T, T) int {
+	return func(a, b T) int {
+		return int(cmp(a, b))
+	}
+}
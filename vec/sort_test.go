@@ -0,0 +1,108 @@
+package vec
+
+import (
+	"slices"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/camdencheek/datastructures/compare"
+)
+
+type keyed struct {
+	key, seq int
+}
+
+func TestSort(t *testing.T) {
+	ints := compare.Ordered[int]()
+
+	t.Run("Sort", func(t *testing.T) {
+		f := func(s []int) bool {
+			v := New(s...).Copy()
+			v.Sort(ints)
+			slices.Sort(s)
+			return slices.Equal(s, v) && v.IsSorted(ints)
+		}
+		require.NoError(t, quick.Check(f, nil))
+
+		v := New(1, 3, 2)
+		v.Sort(ints.Invert())
+		require.Equal(t, New(3, 2, 1), v)
+	})
+
+	t.Run("SortStable", func(t *testing.T) {
+		f := func(keys []uint8) bool {
+			var v Vec[keyed]
+			for i, key := range keys {
+				v.Push(keyed{key: int(key % 8), seq: i})
+			}
+			v.SortStable(compare.By(func(k keyed) int { return k.key }))
+			return slices.IsSortedFunc(v, func(a, b keyed) int {
+				if a.key != b.key {
+					return a.key - b.key
+				}
+				return a.seq - b.seq
+			})
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+
+	t.Run("SortUnstableBy", func(t *testing.T) {
+		v := New("ccc", "a", "bb")
+		SortUnstableBy(v, func(s string) int { return len(s) })
+		require.Equal(t, New("a", "bb", "ccc"), v)
+	})
+
+	t.Run("IsSorted", func(t *testing.T) {
+		require.True(t, New[int]().IsSorted(ints))
+		require.True(t, New(1, 1, 2).IsSorted(ints))
+		require.False(t, New(2, 1).IsSorted(ints))
+	})
+
+	t.Run("BinarySearch", func(t *testing.T) {
+		v := New(1, 3, 5, 7)
+		for i, x := range v {
+			idx, found := v.BinarySearch(x, ints)
+			require.True(t, found)
+			require.Equal(t, i, idx)
+			idx, found = v.BinarySearch(x+1, ints)
+			require.False(t, found)
+			require.Equal(t, i+1, idx)
+		}
+		idx, found := v.BinarySearch(0, ints)
+		require.False(t, found)
+		require.Equal(t, 0, idx)
+	})
+
+	t.Run("PartitionPoint", func(t *testing.T) {
+		v := New(2, 4, 6, 1, 3)
+		isEven := func(i int) bool { return i%2 == 0 }
+		require.Equal(t, 3, v.PartitionPoint(isEven))
+		require.Equal(t, 0, New(1).PartitionPoint(isEven))
+		require.Equal(t, 1, New(2).PartitionPoint(isEven))
+		require.Equal(t, 0, New[int]().PartitionPoint(isEven))
+	})
+
+	t.Run("Dedup", func(t *testing.T) {
+		v := New(1, 1, 2, 3, 3, 3, 1)
+		Dedup(&v)
+		require.Equal(t, New(1, 2, 3, 1), v)
+
+		empty := New[int]()
+		Dedup(&empty)
+		require.Equal(t, 0, empty.Len())
+	})
+
+	t.Run("DedupBy", func(t *testing.T) {
+		v := New(keyed{1, 0}, keyed{1, 1}, keyed{2, 2}, keyed{1, 3})
+		v.DedupBy(compare.By(func(k keyed) int { return k.key }))
+		require.Equal(t, New(keyed{1, 0}, keyed{2, 2}, keyed{1, 3}), v)
+	})
+
+	t.Run("Reverse", func(t *testing.T) {
+		v := New(1, 2, 3)
+		v.Reverse()
+		require.Equal(t, New(3, 2, 1), v)
+	})
+}
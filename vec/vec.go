@@ -1,5 +1,9 @@
 package vec
 
+import (
+	"slices"
+)
+
 type Vec[T any] []T
 
 func New[T any](vals ...T) Vec[T] {
@@ -34,6 +38,7 @@ func (v *Vec[T]) AppendSlice(other []T) {
 // Clear removes all values from the vec without changing
 // its capacity
 func (v *Vec[T]) Clear() {
+	clear(*v)
 	*v = (*v)[:0]
 }
 
@@ -60,8 +65,7 @@ func (v *Vec[T]) Pop() *T {
 	if len(*v) == 0 {
 		return nil
 	}
-	popped := (*v)[len(*v)-1]
-	*v = (*v)[:len(*v)-1]
+	popped := v.popLast()
 	return &popped
 }
 
@@ -71,37 +75,68 @@ func (v *Vec[T]) PopOrZero() (res T) {
 	if len(*v) == 0 {
 		return
 	}
-	popped := (*v)[len(*v)-1]
-	*v = (*v)[:len(*v)-1]
+	return v.popLast()
+}
+
+// popLast removes and returns the last value of a non-empty vec, zeroing
+// the slot it leaves behind.
+func (v *Vec[T]) popLast() T {
+	last := len(*v) - 1
+	popped := (*v)[last]
+	var zero T
+	(*v)[last] = zero
+	*v = (*v)[:last]
 	return popped
 }
 
 // Remove removes the value at index i from the vec, shifting all values
 // after it to the left. It does not change the capacity of the vec.
 func (v *Vec[T]) Remove(i int) {
-	*v = append((*v)[:i], (*v)[i+1:]...)
+	*v = slices.Delete(*v, i, i+1)
 }
 
 // Reserve allocates enough capacity to add at least additional more values to
 // the vec. It does nothing if the capacity of the vec can already handle
-// that many additional values.
+// that many additional values. Otherwise, it grows the capacity with
+// GrowDouble so that repeated calls are amortized constant time. Use
+// ReserveExact to allocate only what is needed.
 func (v *Vec[T]) Reserve(additional int) {
-	requestedCap := len(*v) + additional
-	if cap(*v) >= requestedCap {
+	v.ReserveWith(additional, GrowDouble)
+}
+
+// ReserveExact allocates capacity for exactly additional more values. It
+// does nothing if the capacity of the vec can already handle that many
+// additional values.
+func (v *Vec[T]) ReserveExact(additional int) {
+	v.ReserveWith(additional, GrowExact)
+}
+
+// ReserveWith allocates enough capacity to add at least additional more
+// values to the vec, choosing the new capacity with strategy. It does
+// nothing if the capacity of the vec can already handle that many
+// additional values, or if additional is negative.
+func (v *Vec[T]) ReserveWith(additional int, strategy GrowthStrategy) {
+	if additional <= 0 {
 		return
 	}
-	grown := make([]T, requestedCap)
-	copy(grown, *v)
-	*v = grown
+	required := len(*v) + additional
+	if cap(*v) >= required {
+		return
+	}
+	v.realloc(max(strategy(cap(*v), required), required))
 }
 
-// Grow increases the capacity of the vec to the given size. If the capacity
-// of the vec is already larger than size, it does nothing.
+// Grow increases the capacity of the vec to at least the given size,
+// growing it with GrowDouble. If the capacity of the vec is already at
+// least size, it does nothing.
 func (v *Vec[T]) Grow(size int) {
-	if cap(*v) >= size {
-		return
-	}
-	grown := make([]T, size)
+	v.Reserve(size - len(*v))
+}
+
+// realloc moves the values of the vec into a new backing array with the
+// given capacity.
+func (v *Vec[T]) realloc(newCap int) {
+	grown := make([]T, len(*v), newCap)
 	copy(grown, *v)
 	*v = grown
 }
@@ -117,12 +152,46 @@ func (v *Vec[T]) ShrinkToFit() {
 	*v = shrunk
 }
 
+// ShrinkPolicy decides the capacity a vec should shrink to, given its
+// current length and capacity. Returning the current capacity or more leaves
+// the vec unchanged.
+type ShrinkPolicy func(length, capacity int) int
+
+// ShrinkQuarter is a ShrinkPolicy that halves the capacity while the length
+// is below a quarter of it. Shrinking only at a quarter leaves room to grow
+// again, so alternating pushes and pops do not reallocate every time.
+//
+// The result is intentionally the smallest power-of-two fraction of the
+// capacity whose quarter, rounded down, is at most the length. A length of
+// exactly a quarter of the capacity leaves it unchanged, and short vecs keep
+// some room: ShrinkQuarter(1, 64) is 4 and ShrinkQuarter(0, 64) is 2.
+func ShrinkQuarter(length, capacity int) int {
+	for capacity > 0 && length < capacity/4 {
+		capacity /= 2
+	}
+	return capacity
+}
+
+// ShrinkWith shrinks the capacity of the vec to the capacity chosen by
+// policy, but never below its length. Use Shrinking to apply a policy
+// automatically after every operation that removes values.
+func (v *Vec[T]) ShrinkWith(policy ShrinkPolicy) {
+	newCap := max(policy(len(*v), cap(*v)), len(*v))
+	if newCap >= cap(*v) {
+		return
+	}
+	shrunk := make([]T, len(*v), newCap)
+	copy(shrunk, *v)
+	*v = shrunk
+}
+
 // Truncate keeps `size` values, removing all values after that. It does
 // not change the capacity of the vec.
 func (v *Vec[T]) Truncate(size int) {
 	if len(*v) <= size {
 		return
 	}
+	clear((*v)[size:])
 	*v = (*v)[:size]
 }
 
@@ -147,14 +216,65 @@ func (v Vec[T]) Copy() Vec[T] {
 }
 
 // Filter removes all elements from the vec for which the given predicate
-// function does not return true.
+// function does not return true. It is equivalent to Retain.
 func (v *Vec[T]) Filter(predicate func(T) bool) {
+	v.Retain(predicate)
+}
+
+// Retain keeps only the values for which pred returns true, preserving
+// their order. It compacts the vec in a single pass and zeroes the slots
+// freed at the end so the values they held can be garbage collected.
+func (v *Vec[T]) Retain(pred func(T) bool) {
 	j := 0
-	for i := 0; i < len(*v); {
-		if predicate((*v)[i]) {
+	for i, val := range *v {
+		if pred(val) {
 			(*v)[j] = (*v)[i]
 			j++
 		}
 	}
+	clear((*v)[j:])
 	*v = (*v)[:j]
 }
+
+// RemoveRange removes the values at indexes i through j-1, shifting all
+// values after them to the left. It does not change the capacity of the
+// vec. RemoveRange panics if the range is out of bounds.
+func (v *Vec[T]) RemoveRange(i, j int) {
+	*v = slices.Delete(*v, i, j)
+}
+
+// InsertSlice inserts the values into the vec at index i, shifting all
+// values after it to the right. The tail of the vec is moved only once.
+// InsertSlice panics if i > v.Len().
+func (v *Vec[T]) InsertSlice(i int, vals []T) {
+	*v = slices.Insert(*v, i, vals...)
+}
+
+// Splice replaces the values at indexes i through j-1 with the values of
+// replacement, which may be of a different length. Splice panics if the
+// range is out of bounds.
+func (v *Vec[T]) Splice(i, j int, replacement []T) {
+	*v = slices.Replace(*v, i, j, replacement...)
+}
+
+// SwapRemove removes and returns the value at index i, replacing it with the
+// last value of the vec. It runs in constant time but does not preserve the
+// order of the vec. SwapRemove panics if i is out of range.
+func (v *Vec[T]) SwapRemove(i int) T {
+	removed := (*v)[i]
+	last := v.popLast()
+	if i < len(*v) {
+		(*v)[i] = last
+	}
+	return removed
+}
+
+// Drain removes the values at indexes i through j-1 from the vec and returns
+// an iterator over them. The removed values are copied out first, so the
+// iterator stays valid when the vec is modified. Drain panics if the range
+// is out of bounds.
+func (v *Vec[T]) Drain(i, j int) *Iterator[T] {
+	drained := NewFromSlice(slices.Clone((*v)[i:j]))
+	v.RemoveRange(i, j)
+	return drained.Iter()
+}
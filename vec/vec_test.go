@@ -0,0 +1,204 @@
+package vec
+
+import (
+	"runtime"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/camdencheek/datastructures/compare"
+)
+
+func isEven(i int) bool {
+	return i%2 == 0
+}
+
+func TestVec(t *testing.T) {
+	t.Run("Filter", func(t *testing.T) {
+		v := New(1, 2, 3, 4)
+		v.Filter(isEven)
+		require.Equal(t, New(2, 4), v)
+	})
+
+	t.Run("Retain zeroes the freed tail", func(t *testing.T) {
+		a, b, c := new(int), new(int), new(int)
+		v := New(a, b, c)
+		v.Retain(func(p *int) bool { return p == b })
+		require.Equal(t, New(b), v)
+		require.Equal(t, []*int{b, nil, nil}, []*int(v[:3]))
+	})
+
+	t.Run("Retain matches slices.DeleteFunc", func(t *testing.T) {
+		f := func(s []int) bool {
+			v := New(s...).Copy()
+			v.Retain(isEven)
+			expected := slices.DeleteFunc(s, func(i int) bool { return !isEven(i) })
+			return slices.Equal(expected, v)
+		}
+		require.NoError(t, quick.Check(f, nil))
+	})
+
+	t.Run("RemoveRange", func(t *testing.T) {
+		v := New(1, 2, 3, 4, 5)
+		v.RemoveRange(1, 3)
+		require.Equal(t, New(1, 4, 5), v)
+		require.Equal(t, []int{1, 4, 5, 0, 0}, []int(v[:5]))
+		v.RemoveRange(3, 3)
+		require.Equal(t, New(1, 4, 5), v)
+		require.Panics(t, func() { v.RemoveRange(2, 4) })
+	})
+
+	t.Run("InsertSlice", func(t *testing.T) {
+		v := New(1, 4)
+		v.InsertSlice(1, []int{2, 3})
+		require.Equal(t, New(1, 2, 3, 4), v)
+		v.InsertSlice(4, []int{5})
+		require.Equal(t, New(1, 2, 3, 4, 5), v)
+		require.Panics(t, func() { v.InsertSlice(7, []int{0}) })
+	})
+
+	t.Run("Splice", func(t *testing.T) {
+		v := New(1, 2, 3, 4)
+		v.Splice(1, 3, []int{5})
+		require.Equal(t, New(1, 5, 4), v)
+		v.Splice(1, 2, []int{6, 7, 8})
+		require.Equal(t, New(1, 6, 7, 8, 4), v)
+		v.Splice(0, 5, nil)
+		require.Equal(t, 0, v.Len())
+	})
+
+	t.Run("SwapRemove", func(t *testing.T) {
+		v := New(1, 2, 3, 4)
+		require.Equal(t, 2, v.SwapRemove(1))
+		require.Equal(t, New(1, 4, 3), v)
+		require.Equal(t, 3, v.SwapRemove(2))
+		require.Equal(t, New(1, 4), v)
+		require.Equal(t, []int{1, 4, 0, 0}, []int(v[:4]))
+		require.Panics(t, func() { v.SwapRemove(2) })
+	})
+
+	t.Run("Drain", func(t *testing.T) {
+		v := New(1, 2, 3, 4)
+		it := v.Drain(1, 3)
+		require.Equal(t, New(1, 4), v)
+		v.Push(5)
+		var drained []int
+		for it.Next() {
+			drained = append(drained, it.Value())
+		}
+		require.Equal(t, []int{2, 3}, drained)
+		require.False(t, v.Drain(0, 0).Next())
+	})
+}
+
+// tracked is large enough to avoid the tiny allocator, which can delay
+// finalizers indefinitely.
+type tracked struct {
+	_ [64]byte
+}
+
+// newTrackedVec returns a vec of n values whose finalizers count into
+// released.
+func newTrackedVec(n int, released *atomic.Int32) Vec[*tracked] {
+	v := make(Vec[*tracked], 0, n)
+	for i := 0; i < n; i++ {
+		val := new(tracked)
+		runtime.SetFinalizer(val, func(*tracked) { released.Add(1) })
+		v.Push(val)
+	}
+	return v
+}
+
+// requireReleased runs the garbage collector until want values have been
+// released, failing if that takes too long.
+func requireReleased(t *testing.T, released *atomic.Int32, want int32) {
+	t.Helper()
+	for i := 0; i < 100 && released.Load() < want; i++ {
+		runtime.GC()
+		time.Sleep(time.Millisecond)
+	}
+	require.Equal(t, want, released.Load())
+}
+
+func TestVecReleasesValues(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		shrink func(*Vec[*tracked])
+		// released is how many of four values shrink drops
+		released int32
+	}{
+		{"Pop", func(v *Vec[*tracked]) { v.Pop() }, 1},
+		{"PopOrZero", func(v *Vec[*tracked]) { v.PopOrZero() }, 1},
+		{"Truncate", func(v *Vec[*tracked]) { v.Truncate(1) }, 3},
+		{"Clear", func(v *Vec[*tracked]) { v.Clear() }, 4},
+		{"Remove", func(v *Vec[*tracked]) { v.Remove(0) }, 1},
+		{"RemoveRange", func(v *Vec[*tracked]) { v.RemoveRange(1, 3) }, 2},
+		{"SwapRemove", func(v *Vec[*tracked]) { v.SwapRemove(0) }, 1},
+		{"Splice", func(v *Vec[*tracked]) { v.Splice(0, 3, nil) }, 3},
+		{"Retain", func(v *Vec[*tracked]) { v.Retain(func(*tracked) bool { return false }) }, 4},
+		{"DedupBy", func(v *Vec[*tracked]) { v.DedupBy(func(*tracked, *tracked) compare.Result { return compare.Equal }) }, 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var released atomic.Int32
+			v := newTrackedVec(4, &released)
+			tc.shrink(&v)
+			requireReleased(t, &released, tc.released)
+			require.Equal(t, 4-int(tc.released), v.Len())
+			require.Equal(t, 4, v.Cap())
+			runtime.KeepAlive(v)
+		})
+	}
+}
+
+func TestShrinkWith(t *testing.T) {
+	t.Run("ShrinkQuarter", func(t *testing.T) {
+		require.Equal(t, 64, ShrinkQuarter(16, 64))
+		require.Equal(t, 32, ShrinkQuarter(15, 64))
+		require.Equal(t, 8, ShrinkQuarter(2, 64))
+		require.Equal(t, 2, ShrinkQuarter(0, 64))
+		require.Equal(t, 0, ShrinkQuarter(0, 0))
+	})
+
+	t.Run("ShrinkQuarter boundary", func(t *testing.T) {
+		// A length of exactly a quarter of the capacity is kept as is, and
+		// one less halves the capacity once
+		for capacity := 4; capacity <= 1<<10; capacity *= 2 {
+			require.Equal(t, capacity, ShrinkQuarter(capacity/4, capacity))
+			require.Equal(t, capacity/2, ShrinkQuarter(capacity/4-1, capacity), "capacity %d", capacity)
+		}
+		require.Equal(t, 4, ShrinkQuarter(1, 64))
+		require.Equal(t, 2, ShrinkQuarter(0, 64))
+	})
+
+	t.Run("keeps values", func(t *testing.T) {
+		v := make(Vec[int], 0, 64)
+		v.Push(1)
+		v.Push(2)
+		v.ShrinkWith(ShrinkQuarter)
+		require.Equal(t, New(1, 2), v)
+		require.Equal(t, 8, v.Cap())
+		v.ShrinkWith(ShrinkQuarter)
+		require.Equal(t, 8, v.Cap())
+	})
+
+	t.Run("never below length", func(t *testing.T) {
+		v := make(Vec[int], 3, 8)
+		v.ShrinkWith(func(int, int) int { return 0 })
+		require.Equal(t, 3, v.Len())
+		require.Equal(t, 3, v.Cap())
+	})
+
+	t.Run("releases the old array", func(t *testing.T) {
+		var released atomic.Int32
+		v := newTrackedVec(16, &released)
+		v.Truncate(1)
+		v.ShrinkWith(ShrinkQuarter)
+		requireReleased(t, &released, 15)
+		require.Equal(t, 4, v.Cap())
+		runtime.KeepAlive(v)
+	})
+}